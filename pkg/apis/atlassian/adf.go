@@ -75,6 +75,44 @@ func TextToADF(text string) json.RawMessage {
 	return data
 }
 
+// ADFBlock is a top-level ADF node used to compose documents with ADFDoc.
+type ADFBlock struct {
+	node adfNode
+}
+
+// ADFParagraph builds a paragraph; text supports the same inline markup as TextToADF.
+func ADFParagraph(text string) ADFBlock {
+	p := adfNode{Type: adfTypeParagraph}
+	if text != "" {
+		p.Content = parseInline(text)
+	}
+	return ADFBlock{node: p}
+}
+
+// ADFCodeBlock builds a code block with verbatim content.
+func ADFCodeBlock(code string) ADFBlock {
+	return ADFBlock{node: makeCodeBlock(code)}
+}
+
+// ADFDoc assembles blocks into an ADF document. An empty document gets a
+// single empty paragraph, which Jira requires.
+func ADFDoc(blocks ...ADFBlock) json.RawMessage {
+	doc := adfNode{
+		Type:    adfTypeDoc,
+		Version: 1,
+		Content: make([]adfNode, 0, len(blocks)),
+	}
+	for _, block := range blocks {
+		doc.Content = append(doc.Content, block.node)
+	}
+	if len(doc.Content) == 0 {
+		doc.Content = append(doc.Content, adfNode{Type: adfTypeParagraph})
+	}
+
+	data, _ := json.Marshal(doc)
+	return data
+}
+
 // splitCodeBlocks splits text into paragraphs and code blocks (``` delimited).
 // Fences are only recognised at the beginning of a line (or the beginning of the string).
 func splitCodeBlocks(text string) []adfNode {
@@ -1012,3 +1012,39 @@ func assertRoundTrip(t *testing.T, input string) {
 		t.Fatalf("round-trip failed:\ninput:  %q\noutput: %q", input, got)
 	}
 }
+
+// --- ADF builder ---
+
+func TestADFDoc_Paragraph(t *testing.T) {
+	t.Parallel()
+	doc := mustParseADF(t, ADFDoc(ADFParagraph("Deploy *failed*")))
+	if len(doc.Content) != 1 || doc.Content[0].Type != adfTypeParagraph {
+		t.Fatalf("expected 1 paragraph, got %+v", doc.Content)
+	}
+	nodes := doc.Content[0].Content
+	if len(nodes) != 2 || nodes[0].Text != "Deploy " || nodes[1].Text != "failed" {
+		t.Fatalf("unexpected inline nodes: %+v", nodes)
+	}
+	if !hasMarkType(nodes[1].Marks, adfMarkStrong) {
+		t.Fatalf("expected strong mark: %+v", nodes[1].Marks)
+	}
+}
+
+func TestADFDoc_MixedBlocks(t *testing.T) {
+	t.Parallel()
+	doc := mustParseADF(t, ADFDoc(ADFParagraph("Logs:"), ADFCodeBlock("panic: *boom*")))
+	if len(doc.Content) != 2 || doc.Content[1].Type != adfTypeCodeBlock {
+		t.Fatalf("unexpected blocks: %+v", doc.Content)
+	}
+	if doc.Content[1].Content[0].Text != "panic: *boom*" {
+		t.Fatalf("code block must be verbatim: %q", doc.Content[1].Content[0].Text)
+	}
+}
+
+func TestADFDoc_Empty(t *testing.T) {
+	t.Parallel()
+	doc := mustParseADF(t, ADFDoc())
+	if len(doc.Content) != 1 || doc.Content[0].Type != adfTypeParagraph || len(doc.Content[0].Content) != 0 {
+		t.Fatalf("expected single empty paragraph, got %+v", doc.Content)
+	}
+}
@@ -0,0 +1,66 @@
+package atlassian
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// AgileService provides Jira Software board and sprint lookups (/rest/agile/1.0).
+type AgileService struct {
+	client *Client
+}
+
+// ListSprints lists sprints of a board with optional pagination.
+// With FetchAll, pages are followed by startAt until the server reports isLast.
+func (s *AgileService) ListSprints(ctx context.Context, boardID int, opts *SprintOptions) (*SprintsResult, error) {
+	if boardID <= 0 {
+		return nil, errors.New("atlassian: board ID is required")
+	}
+	if opts == nil {
+		opts = &SprintOptions{}
+	}
+
+	path := fmt.Sprintf("/rest/agile/1.0/board/%d/sprint", boardID)
+	startAt := opts.StartAt
+	combined := make([]Sprint, 0)
+
+	for {
+		params := url.Values{}
+		if startAt > 0 {
+			params.Set("startAt", strconv.Itoa(startAt))
+		}
+		if opts.MaxResults > 0 {
+			params.Set("maxResults", strconv.Itoa(opts.MaxResults))
+		}
+		if strings.TrimSpace(opts.State) != "" {
+			params.Set("state", opts.State)
+		}
+
+		req, err := s.client.newRequest(ctx, http.MethodGet, path, params, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page SprintsResult
+		if err := s.client.transport.DoJSON(req, &page); err != nil {
+			return nil, err
+		}
+		if !opts.FetchAll {
+			return &page, nil
+		}
+
+		combined = append(combined, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			page.Values = combined
+			page.StartAt = opts.StartAt
+			page.IsLast = true
+			return &page, nil
+		}
+		startAt = page.StartAt + len(page.Values)
+	}
+}
@@ -0,0 +1,82 @@
+package atlassian
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
+)
+
+func TestListSprintsFetchAll(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/rest/agile/1.0/board/42/sprint" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("state") != "active" || q.Get("maxResults") != "2" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch q.Get("startAt") {
+		case "":
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":2,"isLast":false,"values":[{"id":1,"state":"active","name":"Sprint 1"},{"id":2,"state":"active","name":"Sprint 2"}]}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"startAt":2,"maxResults":2,"isLast":true,"values":[{"id":3,"state":"active","name":"Sprint 3","originBoardId":42}]}`))
+		default:
+			t.Fatalf("unexpected startAt: %q", q.Get("startAt"))
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	result, err := client.Agile().ListSprints(context.Background(), 42, &SprintOptions{MaxResults: 2, State: "active", FetchAll: true})
+	if err != nil {
+		t.Fatalf("ListSprints failed: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+	if !result.IsLast || result.StartAt != 0 {
+		t.Fatalf("unexpected pagination fields: %+v", result)
+	}
+	if len(result.Values) != 3 || result.Values[0].ID != 1 || result.Values[2].Name != "Sprint 3" || result.Values[2].OriginBoardID != 42 {
+		t.Fatalf("unexpected sprints: %+v", result.Values)
+	}
+}
+
+func TestListSprintsSinglePage(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"startAt":0,"maxResults":1,"isLast":false,"values":[{"id":7,"state":"closed","name":"Old"}]}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	result, err := client.Agile().ListSprints(context.Background(), 1, nil)
+	if err != nil {
+		t.Fatalf("ListSprints failed: %v", err)
+	}
+	if result.IsLast || len(result.Values) != 1 || result.Values[0].ID != 7 {
+		t.Fatalf("expected single page with isLast surfaced, got %+v", result)
+	}
+	if _, err := client.Agile().ListSprints(context.Background(), 0, nil); err == nil {
+		t.Fatalf("expected error for missing board ID")
+	}
+}
@@ -8,13 +8,21 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
 )
 
 const defaultAssetsPageSize = 100
 
+const defaultProgressPollInterval = 2 * time.Second
+
+// aqlOrderBy matches an AQL "order by" keyword pair.
+var aqlOrderBy = regexp.MustCompile(`(?i)\border\s+by\b`)
+
 // AssetsService provides Jira Assets API operations.
 type AssetsService struct {
 	client *Client
@@ -34,6 +42,10 @@ func (s *AssetsService) SearchObjectsAQL(ctx context.Context, aql string, opts *
 	if opts == nil {
 		opts = &AssetsSearchOptions{}
 	}
+	qlQuery, err := scopeAQL(aql, opts.ObjectSchemaID, opts.ObjectTypeID)
+	if err != nil {
+		return nil, err
+	}
 
 	pageSize := opts.PageSize
 	if pageSize <= 0 {
@@ -55,7 +67,7 @@ func (s *AssetsService) SearchObjectsAQL(ctx context.Context, aql string, opts *
 		}
 
 		payload := map[string]any{
-			"qlQuery": aql,
+			"qlQuery": qlQuery,
 		}
 
 		req, err := s.client.newCloudRequest(ctx, http.MethodPost, path, query, payload)
@@ -73,6 +85,7 @@ func (s *AssetsService) SearchObjectsAQL(ctx context.Context, aql string, opts *
 		}
 
 		result.Values = append(result.Values, page.Values...)
+		result.ObjectTypeAttributes = mergeObjectTypeAttributes(result.ObjectTypeAttributes, page.ObjectTypeAttributes)
 		result.Total = page.Total
 		result.IsLast = page.IsLast
 		startAt += len(page.Values)
@@ -82,6 +95,115 @@ func (s *AssetsService) SearchObjectsAQL(ctx context.Context, aql string, opts *
 	}
 }
 
+// mergeObjectTypeAttributes appends the attributes from next whose IDs are not
+// already in attrs.
+func mergeObjectTypeAttributes(attrs, next []ObjectTypeAttribute) []ObjectTypeAttribute {
+	seen := make(map[string]struct{}, len(attrs))
+	for _, attr := range attrs {
+		seen[attr.ID] = struct{}{}
+	}
+	for _, attr := range next {
+		if _, ok := seen[attr.ID]; ok {
+			continue
+		}
+		seen[attr.ID] = struct{}{}
+		attrs = append(attrs, attr)
+	}
+	return attrs
+}
+
+// scopeAQL prefixes aql with objectSchemaId/objectTypeId clauses, since the
+// AQL endpoints accept nothing but qlQuery in the body. A trailing
+// "order by" clause is kept outside the parentheses around aql.
+func scopeAQL(aql, schemaID, typeID string) (string, error) {
+	var clauses []string
+	for _, scope := range []struct{ field, id string }{
+		{"objectSchemaId", schemaID},
+		{"objectTypeId", typeID},
+	} {
+		id := strings.TrimSpace(scope.id)
+		if id == "" {
+			continue
+		}
+		if _, err := strconv.Atoi(id); err != nil {
+			return "", fmt.Errorf("atlassian: %s %q must be numeric", scope.field, scope.id)
+		}
+		clauses = append(clauses, scope.field+" = "+id)
+	}
+	if len(clauses) == 0 {
+		return aql, nil
+	}
+	filter, orderBy := splitAQLOrderBy(aql)
+	scoped := strings.Join(clauses, " AND ") + " AND (" + filter + ")"
+	if orderBy != "" {
+		scoped += " " + orderBy
+	}
+	return scoped, nil
+}
+
+// splitAQLOrderBy splits aql into its filter and a trailing "order by" clause.
+// Matches inside quoted values or parentheses are ignored.
+func splitAQLOrderBy(aql string) (filter, orderBy string) {
+	matches := aqlOrderBy.FindAllStringIndex(aql, -1)
+	for i := len(matches) - 1; i >= 0; i-- {
+		start := matches[i][0]
+		if aqlTopLevel(aql[:start]) {
+			return strings.TrimSpace(aql[:start]), strings.TrimSpace(aql[start:])
+		}
+	}
+	return aql, ""
+}
+
+// aqlTopLevel reports whether the end of prefix is outside quotes and
+// parentheses.
+func aqlTopLevel(prefix string) bool {
+	depth := 0
+	inQuotes := false
+	for i := 0; i < len(prefix); i++ {
+		switch prefix[i] {
+		case '\\':
+			i++
+		case '"':
+			inQuotes = !inQuotes
+		case '(':
+			if !inQuotes {
+				depth++
+			}
+		case ')':
+			if !inQuotes {
+				depth--
+			}
+		}
+	}
+	return !inQuotes && depth == 0
+}
+
+// CountObjectsAQL returns the number of objects matching the AQL query via
+// POST /object/aql/totalcount.
+func (s *AssetsService) CountObjectsAQL(ctx context.Context, aql string) (int, error) {
+	if strings.TrimSpace(aql) == "" {
+		return 0, errors.New("atlassian: aql is required")
+	}
+
+	path, err := s.client.assetsPath("/object/aql/totalcount")
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := s.client.newCloudRequest(ctx, http.MethodPost, path, nil, map[string]any{"qlQuery": aql})
+	if err != nil {
+		return 0, err
+	}
+
+	var result struct {
+		TotalCount int `json:"totalCount"`
+	}
+	if err := s.client.transport.DoJSON(req, &result); err != nil {
+		return 0, err
+	}
+	return result.TotalCount, nil
+}
+
 // CreateObject creates a Jira Assets object.
 func (s *AssetsService) CreateObject(ctx context.Context, payload *CreateAssetObjectRequest) (*AssetObject, error) {
 	if payload == nil {
@@ -128,12 +250,23 @@ func (s *AssetsService) DeleteObject(ctx context.Context, objectID string) error
 
 // UpdateObject updates Jira Assets object.
 func (s *AssetsService) UpdateObject(ctx context.Context, objectID string, payload *UpdateAssetObjectRequest) (*AssetObject, error) {
+	return s.UpdateObjectWithOptions(ctx, objectID, payload, nil)
+}
+
+// UpdateObjectWithOptions updates Jira Assets object and clears the
+// attributes listed in opts. Attributes in payload with no values are sent
+// as an empty list, which Assets treats as clearing the attribute.
+func (s *AssetsService) UpdateObjectWithOptions(ctx context.Context, objectID string, payload *UpdateAssetObjectRequest, opts *UpdateObjectOptions) (*AssetObject, error) {
 	if strings.TrimSpace(objectID) == "" {
 		return nil, errors.New("atlassian: object ID is required")
 	}
 	if payload == nil {
-		return nil, errors.New("atlassian: update object payload is required")
+		if opts == nil || len(opts.ClearAttributes) == 0 {
+			return nil, errors.New("atlassian: update object payload is required")
+		}
+		payload = &UpdateAssetObjectRequest{}
 	}
+	payload = withClearedAttributes(payload, opts)
 
 	path, err := s.client.assetsPath("/object/" + url.PathEscape(objectID))
 	if err != nil {
@@ -168,8 +301,56 @@ func (s *AssetsService) UpdateObject(ctx context.Context, objectID string, paylo
 	return &object, nil
 }
 
+// withClearedAttributes returns a copy of payload where nil value lists are
+// empty and every opts.ClearAttributes ID is present with no values.
+func withClearedAttributes(payload *UpdateAssetObjectRequest, opts *UpdateObjectOptions) *UpdateAssetObjectRequest {
+	out := &UpdateAssetObjectRequest{
+		ObjectTypeID: payload.ObjectTypeID,
+		Attributes:   make([]CreateAssetObjectAttribute, 0, len(payload.Attributes)),
+	}
+	seen := make(map[string]int, len(payload.Attributes))
+	for _, attr := range payload.Attributes {
+		if attr.ObjectAttributeValues == nil {
+			attr.ObjectAttributeValues = []CreateAssetAttributeValue{}
+		}
+		seen[attr.ObjectTypeAttributeID] = len(out.Attributes)
+		out.Attributes = append(out.Attributes, attr)
+	}
+	if opts == nil {
+		return out
+	}
+
+	for _, id := range opts.ClearAttributes {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		cleared := CreateAssetObjectAttribute{
+			ObjectTypeAttributeID: id,
+			ObjectAttributeValues: []CreateAssetAttributeValue{},
+		}
+		if index, ok := seen[id]; ok {
+			out.Attributes[index] = cleared
+			continue
+		}
+		seen[id] = len(out.Attributes)
+		out.Attributes = append(out.Attributes, cleared)
+	}
+	return out
+}
+
 // GetObject fetches Jira Assets object by ID.
 func (s *AssetsService) GetObject(ctx context.Context, objectID string) (*AssetObject, error) {
+	return s.GetObjectWithOptions(ctx, objectID, nil)
+}
+
+// GetObjectWithOptions fetches Jira Assets object by ID, optionally limiting
+// returned attributes. GET /object/{id} has no query parameters for this, so
+// the full object is fetched and opts are applied to the response: a
+// non-empty AttributesToDisplay keeps only those attribute IDs (and implies
+// IncludeAttributes), otherwise attributes are dropped unless
+// IncludeAttributes is set. nil opts return the object unchanged.
+func (s *AssetsService) GetObjectWithOptions(ctx context.Context, objectID string, opts *GetObjectOptions) (*AssetObject, error) {
 	if strings.TrimSpace(objectID) == "" {
 		return nil, errors.New("atlassian: object ID is required")
 	}
@@ -188,9 +369,140 @@ func (s *AssetsService) GetObject(ctx context.Context, objectID string) (*AssetO
 	if err := s.client.transport.DoJSON(req, &object); err != nil {
 		return nil, err
 	}
+	if opts != nil {
+		object.Attributes = filterObjectAttributes(object.Attributes, opts)
+	}
 	return &object, nil
 }
 
+func filterObjectAttributes(attrs []AssetObjectAttr, opts *GetObjectOptions) []AssetObjectAttr {
+	keep := make(map[string]struct{}, len(opts.AttributesToDisplay))
+	for _, id := range opts.AttributesToDisplay {
+		if trimmed := strings.TrimSpace(id); trimmed != "" {
+			keep[trimmed] = struct{}{}
+		}
+	}
+	if len(keep) == 0 {
+		if opts.IncludeAttributes {
+			return attrs
+		}
+		return nil
+	}
+
+	filtered := make([]AssetObjectAttr, 0, len(keep))
+	for _, attr := range attrs {
+		if _, ok := keep[attr.ObjectTypeAttributeID]; ok {
+			filtered = append(filtered, attr)
+		}
+	}
+	return filtered
+}
+
+// ListObjectAttachments lists files attached to an Assets object.
+func (s *AssetsService) ListObjectAttachments(ctx context.Context, objectID string) ([]AssetAttachment, error) {
+	if strings.TrimSpace(objectID) == "" {
+		return nil, errors.New("atlassian: object ID is required")
+	}
+
+	path, err := s.client.assetsPath("/attachments/object/" + url.PathEscape(objectID))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.newCloudRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var attachments []AssetAttachment
+	if err := s.client.transport.DoJSON(req, &attachments); err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
+
+// DownloadAssetAttachment downloads attachment content and returns it
+// together with the response Content-Type.
+func (s *AssetsService) DownloadAssetAttachment(ctx context.Context, attachmentID string) ([]byte, string, error) {
+	if strings.TrimSpace(attachmentID) == "" {
+		return nil, "", errors.New("atlassian: attachment ID is required")
+	}
+
+	path, err := s.client.assetsPath("/attachments/" + url.PathEscape(attachmentID) + "/download")
+	if err != nil {
+		return nil, "", err
+	}
+
+	req, err := s.client.newCloudRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("Accept", "*/*")
+
+	data, header, err := s.client.transport.DoBytes(req)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, header.Get("Content-Type"), nil
+}
+
+// GetProgress returns the state of an async Assets operation, e.g.
+// category "imports" with the identifier returned when the import started.
+func (s *AssetsService) GetProgress(ctx context.Context, category, identifier string) (*ProgressStatus, error) {
+	if strings.TrimSpace(category) == "" {
+		return nil, errors.New("atlassian: progress category is required")
+	}
+	if strings.TrimSpace(identifier) == "" {
+		return nil, errors.New("atlassian: progress identifier is required")
+	}
+
+	path, err := s.client.assetsPath("/progress/category/" + url.PathEscape(category) + "/" + url.PathEscape(identifier))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.newCloudRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var status ProgressStatus
+	if err := s.client.transport.DoJSON(req, &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// WaitForProgress polls GetProgress every poll interval (2s if <=0) until the
+// operation finishes or ctx is done. A FAILED or CANCELLED operation is
+// returned together with an error.
+func (s *AssetsService) WaitForProgress(ctx context.Context, category, identifier string, poll time.Duration) (*ProgressStatus, error) {
+	if poll <= 0 {
+		poll = defaultProgressPollInterval
+	}
+
+	for {
+		status, err := s.GetProgress(ctx, category, identifier)
+		if err != nil {
+			return nil, err
+		}
+		if status.Finished() {
+			if status.Status != ProgressStatusDone {
+				return status, fmt.Errorf("atlassian: %s %s finished with status %s", category, identifier, status.Status)
+			}
+			return status, nil
+		}
+
+		timer := time.NewTimer(poll)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return status, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
 // GetObjectSchema fetches a Jira Assets object schema by ID.
 func (s *AssetsService) GetObjectSchema(ctx context.Context, schemaID string) (*ObjectSchema, error) {
 	if strings.TrimSpace(schemaID) == "" {
@@ -279,6 +591,55 @@ func (s *AssetsService) GetObjectTypeAttributes(ctx context.Context, objectTypeI
 	return attrs, nil
 }
 
+// ObjectBuilder builds CreateAssetObjectRequest using attribute names instead
+// of numeric attribute IDs. Create it with AssetsService.NewObjectBuilder.
+type ObjectBuilder struct {
+	objectTypeID string
+	attrIDs      map[string]string
+	attributes   []AssetAttributeInput
+	err          error
+}
+
+// NewObjectBuilder fetches attribute definitions of the object type and
+// returns a builder resolving attribute names (case-insensitive) to IDs.
+func (s *AssetsService) NewObjectBuilder(ctx context.Context, objectTypeID string) (*ObjectBuilder, error) {
+	attrs, err := s.GetObjectTypeAttributes(ctx, objectTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	attrIDs := make(map[string]string, len(attrs))
+	for _, attr := range attrs {
+		attrIDs[strings.ToLower(attr.Name)] = attr.ID
+	}
+	return &ObjectBuilder{objectTypeID: objectTypeID, attrIDs: attrIDs}, nil
+}
+
+// Set adds attribute values by attribute name. Unknown names are reported by Build.
+func (b *ObjectBuilder) Set(name string, values ...string) *ObjectBuilder {
+	if b.err != nil {
+		return b
+	}
+	id, ok := b.attrIDs[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		b.err = fmt.Errorf("atlassian: unknown attribute %q for object type %s", name, b.objectTypeID)
+		return b
+	}
+	b.attributes = append(b.attributes, AssetAttributeInput{ObjectTypeAttributeID: id, Values: values})
+	return b
+}
+
+// Build returns the create request or the first Set error.
+func (b *ObjectBuilder) Build() (*CreateAssetObjectRequest, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return NewCreateAssetObjectRequest(AssetObjectInput{
+		ObjectTypeID: b.objectTypeID,
+		Attributes:   b.attributes,
+	}), nil
+}
+
 func (c *Client) assetsPath(pathSuffix string) (string, error) {
 	if strings.TrimSpace(c.assetsCloudID) == "" {
 		return "", errors.New("atlassian: assets cloud ID is required")
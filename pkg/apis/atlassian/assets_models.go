@@ -1,14 +1,40 @@
 package atlassian
 
+import "encoding/json"
+
 // AssetsSearchOptions controls AQL pagination and response shape.
 type AssetsSearchOptions struct {
 	StartAt           int
 	PageSize          int
 	FetchAll          bool
 	IncludeAttributes bool
+	// ObjectSchemaID and ObjectTypeID optionally constrain the search scope.
+	// They must be numeric and are added to the query as
+	// "objectSchemaId = X AND objectTypeId = Y AND (<aql>)"; a trailing
+	// "order by" clause of aql stays after the closing parenthesis.
+	ObjectSchemaID string
+	ObjectTypeID   string
 }
 
-// AssetsSearchResult is a paginated Assets AQL response.
+// GetObjectOptions controls which attributes GetObjectWithOptions returns.
+// The Assets API returns all attributes, so they are filtered client-side.
+type GetObjectOptions struct {
+	IncludeAttributes bool
+	// AttributesToDisplay limits returned attributes to the given attribute
+	// IDs; a non-empty list implies IncludeAttributes.
+	AttributesToDisplay []string
+}
+
+// UpdateObjectOptions controls UpdateObjectWithOptions.
+type UpdateObjectOptions struct {
+	// ClearAttributes lists object type attribute IDs to clear by sending
+	// them with an empty value list.
+	ClearAttributes []string
+}
+
+// AssetsSearchResult is a paginated Assets AQL response. ObjectTypeAttributes
+// holds the definitions of the attributes present in Values, as returned by
+// POST /object/aql with each page; with FetchAll they are merged by ID.
 type AssetsSearchResult struct {
 	StartAt              int                   `json:"startAt"`
 	MaxResults           int                   `json:"maxResults"`
@@ -147,6 +173,17 @@ func (r *AssetsSearchResult) FindObjectByLabel(label string) *AssetObject {
 	return nil
 }
 
+// AttributeName returns the name of the object type attribute with id, or an
+// empty string when it is not part of ObjectTypeAttributes.
+func (r *AssetsSearchResult) AttributeName(id string) string {
+	for i := range r.ObjectTypeAttributes {
+		if r.ObjectTypeAttributes[i].ID == id {
+			return r.ObjectTypeAttributes[i].Name
+		}
+	}
+	return ""
+}
+
 // GetAttributeByID returns an attribute by its ObjectTypeAttributeID.
 func (o *AssetObject) GetAttributeByID(attributeID string) *AssetObjectAttr {
 	for i := range o.Attributes {
@@ -284,6 +321,17 @@ type ReferenceType struct {
 	Name        string `json:"name"`
 }
 
+// AssetAttachment describes a file attached to an Assets object.
+type AssetAttachment struct {
+	ID       string `json:"id"`
+	Filename string `json:"filename"`
+	MimeType string `json:"mimeType,omitempty"`
+	Created  string `json:"created,omitempty"`
+	// Size is the human-readable file size reported by Assets, e.g. "12 kB".
+	Size string `json:"filesize,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
 // NewUpdateAssetObjectRequest builds an UpdateAssetObjectRequest from simplified input.
 func NewUpdateAssetObjectRequest(input AssetObjectInput) *UpdateAssetObjectRequest {
 	req := &UpdateAssetObjectRequest{
@@ -304,3 +352,31 @@ func NewUpdateAssetObjectRequest(input AssetObjectInput) *UpdateAssetObjectReque
 
 	return req
 }
+
+// Assets progress states reported by GetProgress.
+const (
+	ProgressStatusInProgress = "IN_PROGRESS"
+	ProgressStatusDone       = "DONE"
+	ProgressStatusFailed     = "FAILED"
+	ProgressStatusCancelled  = "CANCELLED"
+)
+
+// ProgressStatus is the state of an async Assets operation such as an import.
+type ProgressStatus struct {
+	ID       string `json:"id,omitempty"`
+	Category string `json:"category,omitempty"`
+	Status   string `json:"status"`
+	// Progress is completion in percent (0-100).
+	Progress int `json:"progressInPercent"`
+	// Result is the operation-specific outcome, present once finished.
+	Result json.RawMessage `json:"result,omitempty"`
+}
+
+// Finished reports whether the operation reached a terminal state.
+func (p *ProgressStatus) Finished() bool {
+	switch p.Status {
+	case ProgressStatusDone, ProgressStatusFailed, ProgressStatusCancelled:
+		return true
+	}
+	return false
+}
@@ -3,10 +3,12 @@ package atlassian
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
 )
@@ -44,6 +46,113 @@ func TestGetObjectBuildsAssetsURL(t *testing.T) {
 	}
 }
 
+func TestGetObjectWithOptions(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ex/jira/cloud-1/jsm/assets/workspace/ws-9/v1/object/42" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if r.URL.RawQuery != "" {
+			t.Fatalf("GET /object/{id} takes no query parameters, got %q", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"42","label":"Server-42","attributes":[
+			{"objectTypeAttributeId":"135","objectAttributeValues":[{"value":"Server-42"}]},
+			{"objectTypeAttributeId":"144","objectAttributeValues":[{"value":"R-7"}]},
+			{"objectTypeAttributeId":"150","objectAttributeValues":[{"value":"10.0.0.42"}]}]}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithCloudBaseURL(srv.URL),
+		WithAssetsCloudID("cloud-1"),
+		WithAssetsWorkspaceID("ws-9"),
+		WithTransport(transport.New()),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	object, err := client.Assets().GetObjectWithOptions(context.Background(), "42", &GetObjectOptions{
+		AttributesToDisplay: []string{"135", " ", "144"},
+	})
+	if err != nil {
+		t.Fatalf("GetObjectWithOptions failed: %v", err)
+	}
+	if object.Label != "Server-42" || len(object.Attributes) != 2 ||
+		object.Attributes[0].ObjectTypeAttributeID != "135" || object.Attributes[1].ObjectTypeAttributeID != "144" {
+		t.Fatalf("expected attributes 135 and 144 only, got %+v", object)
+	}
+
+	object, err = client.Assets().GetObjectWithOptions(context.Background(), "42", &GetObjectOptions{})
+	if err != nil {
+		t.Fatalf("GetObjectWithOptions failed: %v", err)
+	}
+	if len(object.Attributes) != 0 {
+		t.Fatalf("expected attributes to be dropped, got %+v", object.Attributes)
+	}
+
+	object, err = client.Assets().GetObjectWithOptions(context.Background(), "42", &GetObjectOptions{IncludeAttributes: true})
+	if err != nil {
+		t.Fatalf("GetObjectWithOptions failed: %v", err)
+	}
+	if len(object.Attributes) != 3 {
+		t.Fatalf("expected all attributes, got %+v", object.Attributes)
+	}
+}
+
+func TestListObjectAttachmentsAndDownload(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ex/jira/cloud-1/jsm/assets/workspace/ws-9/v1/attachments/object/42":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[{"id":"7","filename":"rack.png","mimeType":"image/png","created":"2024-01-02T03:04:05Z","filesize":"3 B","url":"https://example/att/7"}]`))
+		case "/ex/jira/cloud-1/jsm/assets/workspace/ws-9/v1/attachments/7/download":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write([]byte{0x89, 'P', 'N'})
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithCloudBaseURL(srv.URL),
+		WithAssetsCloudID("cloud-1"),
+		WithAssetsWorkspaceID("ws-9"),
+		WithTransport(transport.New()),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	attachments, err := client.Assets().ListObjectAttachments(context.Background(), "42")
+	if err != nil {
+		t.Fatalf("ListObjectAttachments failed: %v", err)
+	}
+	if len(attachments) != 1 || attachments[0].ID != "7" || attachments[0].Filename != "rack.png" ||
+		attachments[0].MimeType != "image/png" || attachments[0].Size != "3 B" || attachments[0].Created == "" {
+		t.Fatalf("unexpected attachments: %+v", attachments)
+	}
+
+	data, contentType, err := client.Assets().DownloadAssetAttachment(context.Background(), "7")
+	if err != nil {
+		t.Fatalf("DownloadAssetAttachment failed: %v", err)
+	}
+	if string(data) != "\x89PN" || contentType != "image/png" {
+		t.Fatalf("unexpected download: %q %q", data, contentType)
+	}
+
+	if _, err := client.Assets().ListObjectAttachments(context.Background(), " "); err == nil {
+		t.Fatalf("expected error for empty object ID")
+	}
+}
+
 func TestSearchObjectsAQLFetchAll(t *testing.T) {
 	t.Parallel()
 
@@ -107,6 +216,211 @@ func TestSearchObjectsAQLFetchAll(t *testing.T) {
 	}
 }
 
+func TestCountObjectsAQL(t *testing.T) {
+	t.Parallel()
+
+	requestCount := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		if r.Method != http.MethodPost || r.URL.Path != "/ex/jira/cloud-7/jsm/assets/workspace/ws-7/v1/object/aql/totalcount" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		if len(payload) != 1 || payload["qlQuery"] != "objectType = Server" {
+			t.Fatalf("unexpected payload: %v", payload)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"totalCount":137}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithCloudBaseURL(srv.URL),
+		WithAssetsCloudID("cloud-7"),
+		WithAssetsWorkspaceID("ws-7"),
+		WithTransport(transport.New()),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	total, err := client.Assets().CountObjectsAQL(context.Background(), "objectType = Server")
+	if err != nil {
+		t.Fatalf("CountObjectsAQL failed: %v", err)
+	}
+	if total != 137 {
+		t.Fatalf("unexpected total: %d", total)
+	}
+	if requestCount != 1 {
+		t.Fatalf("expected single request, got %d", requestCount)
+	}
+
+	if _, err := client.Assets().CountObjectsAQL(context.Background(), " "); err == nil {
+		t.Fatalf("expected error for empty aql")
+	}
+}
+
+func TestSearchObjectsAQLScopeAndTypeAttributes(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		if len(payload) != 1 || payload["qlQuery"] != "objectSchemaId = 3 AND objectTypeId = 12 AND (Name = NY-1 OR Name = NY-2)" {
+			t.Fatalf("unexpected payload: %v", payload)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"startAt":0,"maxResults":100,"total":1,"isLast":true,
+			"values":[{"id":"1","attributes":[{"objectTypeAttributeId":"135","objectAttributeValues":[{"value":"NY-1"}]}]}],
+			"objectTypeAttributes":[{"id":"135","name":"Name","label":true},{"id":"144","name":"Rack"}]}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithCloudBaseURL(srv.URL),
+		WithAssetsCloudID("cloud-7"),
+		WithAssetsWorkspaceID("ws-7"),
+		WithTransport(transport.New()),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	result, err := client.Assets().SearchObjectsAQL(context.Background(), "Name = NY-1 OR Name = NY-2", &AssetsSearchOptions{
+		ObjectSchemaID: "3",
+		ObjectTypeID:   "12",
+		FetchAll:       true,
+	})
+	if err != nil {
+		t.Fatalf("SearchObjectsAQL failed: %v", err)
+	}
+	if len(result.ObjectTypeAttributes) != 2 {
+		t.Fatalf("expected object type attributes to be kept with FetchAll, got %+v", result.ObjectTypeAttributes)
+	}
+	if attr := result.ObjectTypeAttributes[0]; attr.ID != "135" || attr.Name != "Name" || !attr.Label {
+		t.Fatalf("unexpected attribute: %+v", attr)
+	}
+
+	if _, err := client.Assets().SearchObjectsAQL(context.Background(), "Name = NY-1", &AssetsSearchOptions{ObjectTypeID: "12) OR (1 = 1"}); err == nil {
+		t.Fatalf("expected error for non-numeric object type ID")
+	}
+}
+
+func TestSearchObjectsAQLScopeKeepsOrderByOutside(t *testing.T) {
+	t.Parallel()
+
+	var gotQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			QLQuery string `json:"qlQuery"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		gotQuery = payload.QLQuery
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"startAt":0,"maxResults":100,"total":0,"isLast":true}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithCloudBaseURL(srv.URL),
+		WithAssetsCloudID("cloud-7"),
+		WithAssetsWorkspaceID("ws-7"),
+		WithTransport(transport.New()),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	cases := []struct {
+		aql  string
+		want string
+	}{
+		{
+			aql:  "Name like NY ORDER BY Name desc",
+			want: "objectTypeId = 12 AND (Name like NY) ORDER BY Name desc",
+		},
+		{
+			aql:  `Name = "order by" order by Created`,
+			want: `objectTypeId = 12 AND (Name = "order by") order by Created`,
+		},
+		{
+			aql:  `Name = "order by x"`,
+			want: `objectTypeId = 12 AND (Name = "order by x")`,
+		},
+	}
+	for _, tc := range cases {
+		if _, err := client.Assets().SearchObjectsAQL(context.Background(), tc.aql, &AssetsSearchOptions{ObjectTypeID: "12"}); err != nil {
+			t.Fatalf("SearchObjectsAQL(%q) failed: %v", tc.aql, err)
+		}
+		if gotQuery != tc.want {
+			t.Fatalf("SearchObjectsAQL(%q) sent %q, want %q", tc.aql, gotQuery, tc.want)
+		}
+	}
+}
+
+func TestSearchObjectsAQLMergesTypeAttributesAcrossPages(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("includeTypeAttributes") {
+			t.Fatalf("unexpected includeTypeAttributes param: %q", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("startAt") == "0" {
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":1,"total":2,"isLast":false,
+				"values":[{"id":"1","attributes":[{"objectTypeAttributeId":"135","objectAttributeValues":[{"value":"NY-1"}]}]}],
+				"objectTypeAttributes":[{"id":"135","name":"Name","label":true}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"startAt":1,"maxResults":1,"total":2,"isLast":true,
+			"values":[{"id":"2","attributes":[{"objectTypeAttributeId":"144","objectAttributeValues":[{"value":"R-7"}]}]}],
+			"objectTypeAttributes":[{"id":"135","name":"Name","label":true},{"id":"144","name":"Rack"}]}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithCloudBaseURL(srv.URL),
+		WithAssetsCloudID("cloud-7"),
+		WithAssetsWorkspaceID("ws-7"),
+		WithTransport(transport.New()),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	result, err := client.Assets().SearchObjectsAQL(context.Background(), "objectType IN (Host, Server)", &AssetsSearchOptions{
+		PageSize: 1,
+		FetchAll: true,
+	})
+	if err != nil {
+		t.Fatalf("SearchObjectsAQL failed: %v", err)
+	}
+	if len(result.Values) != 2 {
+		t.Fatalf("expected 2 objects, got %d", len(result.Values))
+	}
+	if len(result.ObjectTypeAttributes) != 2 {
+		t.Fatalf("expected 2 merged object type attributes, got %+v", result.ObjectTypeAttributes)
+	}
+	attrID := result.Values[1].Attributes[0].ObjectTypeAttributeID
+	if name := result.AttributeName(attrID); name != "Rack" {
+		t.Fatalf("expected attribute %s to resolve to Rack, got %q", attrID, name)
+	}
+	if name := result.AttributeName("999"); name != "" {
+		t.Fatalf("expected empty name for unknown attribute, got %q", name)
+	}
+}
+
 func TestAssetsPathRequiresCloudAndWorkspace(t *testing.T) {
 	t.Parallel()
 
@@ -473,6 +787,68 @@ func TestUpdateObject(t *testing.T) {
 	}
 }
 
+func TestUpdateObjectWithOptionsClearsAttributes(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Attributes []struct {
+				ObjectTypeAttributeID string            `json:"objectTypeAttributeId"`
+				ObjectAttributeValues []json.RawMessage `json:"objectAttributeValues"`
+			} `json:"attributes"`
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		if strings.Contains(string(body), "null") {
+			t.Fatalf("expected empty arrays instead of null: %s", body)
+		}
+		if len(payload.Attributes) != 3 {
+			t.Fatalf("expected 3 attributes, got %s", body)
+		}
+		got := map[string]int{}
+		for _, attr := range payload.Attributes {
+			got[attr.ObjectTypeAttributeID] = len(attr.ObjectAttributeValues)
+		}
+		if got["135"] != 1 || got["136"] != 0 || got["137"] != 0 {
+			t.Fatalf("unexpected attribute values: %s", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"42"}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithCloudBaseURL(srv.URL),
+		WithAssetsCloudID("cloud-1"),
+		WithAssetsWorkspaceID("ws-1"),
+		WithTransport(transport.New()),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	payload := &UpdateAssetObjectRequest{
+		Attributes: []CreateAssetObjectAttribute{
+			{ObjectTypeAttributeID: "135", ObjectAttributeValues: []CreateAssetAttributeValue{{Value: "NY-2"}}},
+			{ObjectTypeAttributeID: "136"},
+		},
+	}
+	if _, err := client.Assets().UpdateObjectWithOptions(context.Background(), "42", payload, &UpdateObjectOptions{
+		ClearAttributes: []string{"137", " "},
+	}); err != nil {
+		t.Fatalf("UpdateObjectWithOptions failed: %v", err)
+	}
+	if payload.Attributes[1].ObjectAttributeValues != nil || len(payload.Attributes) != 2 {
+		t.Fatalf("expected caller payload to stay unchanged: %+v", payload)
+	}
+}
+
 func TestUpdateObjectValidation(t *testing.T) {
 	t.Parallel()
 
@@ -795,3 +1171,108 @@ func TestNewUpdateAssetObjectRequest(t *testing.T) {
 		t.Fatalf("unexpected value: %q", req.Attributes[0].ObjectAttributeValues[0].Value)
 	}
 }
+
+func TestObjectBuilder(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		wantPath := "/ex/jira/cloud-1/jsm/assets/workspace/ws-1/v1/objecttype/23/attributes"
+		if r.URL.Path != wantPath {
+			t.Fatalf("unexpected path: got=%s want=%s", r.URL.Path, wantPath)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"id":"135","name":"Name","label":true},
+			{"id":"144","name":"Owner"},
+			{"id":"150","name":"Tags"}
+		]`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithCloudBaseURL(srv.URL),
+		WithAssetsCloudID("cloud-1"),
+		WithAssetsWorkspaceID("ws-1"),
+		WithTransport(transport.New()),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	builder, err := client.Assets().NewObjectBuilder(context.Background(), "23")
+	if err != nil {
+		t.Fatalf("NewObjectBuilder failed: %v", err)
+	}
+
+	req, err := builder.Set("Name", "NY-1").Set("owner", "acc-1").Set("Tags", "prod", "edge").Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	if req.ObjectTypeID != "23" || len(req.Attributes) != 3 {
+		t.Fatalf("unexpected request: %+v", req)
+	}
+	if req.Attributes[0].ObjectTypeAttributeID != "135" || req.Attributes[0].ObjectAttributeValues[0].Value != "NY-1" {
+		t.Fatalf("unexpected Name attribute: %+v", req.Attributes[0])
+	}
+	if req.Attributes[1].ObjectTypeAttributeID != "144" {
+		t.Fatalf("expected case-insensitive Owner lookup, got %+v", req.Attributes[1])
+	}
+	if len(req.Attributes[2].ObjectAttributeValues) != 2 {
+		t.Fatalf("unexpected Tags values: %+v", req.Attributes[2])
+	}
+
+	_, err = builder.Set("Rack", "A1").Build()
+	if err == nil || !strings.Contains(err.Error(), `unknown attribute "Rack"`) {
+		t.Fatalf("expected unknown attribute error, got %v", err)
+	}
+}
+
+func TestWaitForProgressPollsUntilDone(t *testing.T) {
+	t.Parallel()
+
+	polls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		wantPath := "/ex/jira/cloud-1/jsm/assets/workspace/ws-9/v1/progress/category/imports/imp-1"
+		if r.URL.Path != wantPath {
+			t.Fatalf("unexpected path: got=%s want=%s", r.URL.Path, wantPath)
+		}
+		polls++
+		w.Header().Set("Content-Type", "application/json")
+		if polls < 3 {
+			_, _ = w.Write([]byte(`{"id":"imp-1","category":"imports","status":"IN_PROGRESS","progressInPercent":40}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"imp-1","category":"imports","status":"DONE","progressInPercent":100,"result":{"objectsCreated":12}}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithCloudBaseURL(srv.URL),
+		WithAssetsCloudID("cloud-1"),
+		WithAssetsWorkspaceID("ws-9"),
+		WithTransport(transport.New()),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	status, err := client.Assets().WaitForProgress(context.Background(), "imports", "imp-1", time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForProgress failed: %v", err)
+	}
+	if polls != 3 {
+		t.Fatalf("expected 3 polls, got %d", polls)
+	}
+	if status.Status != ProgressStatusDone || status.Progress != 100 || string(status.Result) != `{"objectsCreated":12}` {
+		t.Fatalf("unexpected status: %+v", status)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	polls = 0
+	if _, err := client.Assets().WaitForProgress(ctx, "imports", "imp-1", time.Hour); err == nil {
+		t.Fatalf("expected error for cancelled context")
+	}
+}
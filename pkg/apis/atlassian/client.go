@@ -34,6 +34,10 @@ type Auth struct {
 	Token string
 }
 
+// TokenSource returns a bearer token for a request. Implementations are
+// responsible for caching and refreshing tokens (e.g. OAuth 2.0 3LO).
+type TokenSource func(ctx context.Context) (string, error)
+
 // Option configures Atlassian client.
 type Option func(*config) error
 
@@ -41,6 +45,7 @@ type config struct {
 	baseURL           string
 	cloudBaseURL      string
 	auth              Auth
+	tokenSource       TokenSource
 	transport         *transport.Client
 	assetsCloudID     string
 	assetsWorkspaceID string
@@ -52,6 +57,7 @@ type Client struct {
 	baseURL           *url.URL
 	cloudBaseURL      *url.URL
 	auth              Auth
+	tokenSource       TokenSource
 	transport         *transport.Client
 	assetsCloudID     string
 	assetsWorkspaceID string
@@ -61,6 +67,8 @@ type Client struct {
 	users      *UsersService
 	assets     *AssetsService
 	operations *OperationsService
+	projects   *ProjectsService
+	agile      *AgileService
 }
 
 // NewClient creates Atlassian client.
@@ -99,11 +107,15 @@ func NewClient(opts ...Option) (*Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("atlassian: parse cloud base URL: %w", err)
 	}
+	if parsedCloudURL.Scheme == "" || parsedCloudURL.Host == "" {
+		return nil, errors.New("atlassian: cloud base URL must include scheme and host")
+	}
 
 	client := &Client{
 		baseURL:           parsedURL,
 		cloudBaseURL:      parsedCloudURL,
 		auth:              cfg.auth,
+		tokenSource:       cfg.tokenSource,
 		transport:         cfg.transport,
 		assetsCloudID:     cfg.assetsCloudID,
 		assetsWorkspaceID: cfg.assetsWorkspaceID,
@@ -116,6 +128,8 @@ func NewClient(opts ...Option) (*Client, error) {
 	client.users = &UsersService{client: client}
 	client.assets = &AssetsService{client: client}
 	client.operations = &OperationsService{client: client}
+	client.projects = &ProjectsService{client: client}
+	client.agile = &AgileService{client: client}
 
 	return client, nil
 }
@@ -136,6 +150,15 @@ func WithAuth(auth Auth) Option {
 	}
 }
 
+// WithTokenSource sets a per-request bearer token source. When set it
+// overrides WithAuth and is called with the request context.
+func WithTokenSource(source TokenSource) Option {
+	return func(cfg *config) error {
+		cfg.tokenSource = source
+		return nil
+	}
+}
+
 // WithTransport injects shared transport.
 func WithTransport(tr *transport.Client) Option {
 	return func(cfg *config) error {
@@ -144,8 +167,11 @@ func WithTransport(tr *transport.Client) Option {
 	}
 }
 
-// WithCloudBaseURL overrides the default Atlassian Cloud API base URL.
-// Useful for testing with mock servers. Defaults to DefaultCloudBaseURL.
+// WithCloudBaseURL overrides the Atlassian Cloud API base URL used by Assets
+// and Operations (/ex/jira/..., /jsm/ops/...). Issues and Users always use the
+// site URL from WithBaseURL. Cloud requests use this URL when set and
+// DefaultCloudBaseURL otherwise; they never fall back to the site URL, because
+// cloud endpoints are only served from api.atlassian.com.
 func WithCloudBaseURL(cloudBaseURL string) Option {
 	return func(cfg *config) error {
 		cfg.cloudBaseURL = cloudBaseURL
@@ -197,6 +223,16 @@ func (c *Client) Operations() *OperationsService {
 	return c.operations
 }
 
+// Projects returns Jira projects API service.
+func (c *Client) Projects() *ProjectsService {
+	return c.projects
+}
+
+// Agile returns Jira Software (boards, sprints) API service.
+func (c *Client) Agile() *AgileService {
+	return c.agile
+}
+
 // newRequest creates an HTTP request resolved against the Jira base URL (issues, users, etc.).
 func (c *Client) newRequest(ctx context.Context, method, path string, query url.Values, body any) (*http.Request, error) {
 	return c.buildRequest(ctx, c.baseURL, method, path, query, body)
@@ -253,7 +289,7 @@ func (c *Client) buildRawRequest(ctx context.Context, baseURL *url.URL, method,
 	}
 	req.Header.Set("Accept", "application/json")
 
-	authValue, err := c.authHeaderValue()
+	authValue, err := c.authHeaderValue(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -264,7 +300,18 @@ func (c *Client) buildRawRequest(ctx context.Context, baseURL *url.URL, method,
 	return req, nil
 }
 
-func (c *Client) authHeaderValue() (string, error) {
+func (c *Client) authHeaderValue(ctx context.Context) (string, error) {
+	if c.tokenSource != nil {
+		token, err := c.tokenSource(ctx)
+		if err != nil {
+			return "", fmt.Errorf("atlassian: token source: %w", err)
+		}
+		if strings.TrimSpace(token) == "" {
+			return "", errors.New("atlassian: token source returned empty token")
+		}
+		return "Bearer " + token, nil
+	}
+
 	switch c.auth.Mode {
 	case "":
 		return "", nil
@@ -66,6 +66,113 @@ func TestClientAuthHeaders(t *testing.T) {
 	}
 }
 
+func TestClientTokenSource(t *testing.T) {
+	t.Parallel()
+
+	var seen []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","key":"ABC-1"}`))
+	}))
+	defer srv.Close()
+
+	type ctxKey struct{}
+	calls := 0
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithAuth(Auth{Mode: AuthBearerToken, Token: "static"}),
+		WithTokenSource(func(ctx context.Context) (string, error) {
+			if ctx.Value(ctxKey{}) != "marker" {
+				t.Fatalf("token source did not receive request context")
+			}
+			calls++
+			return fmt.Sprintf("rotated-%d", calls), nil
+		}),
+		WithTransport(transport.New()),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "marker")
+	for i := 0; i < 2; i++ {
+		if _, err := client.Issues().GetIssue(ctx, "ABC-1"); err != nil {
+			t.Fatalf("GetIssue failed: %v", err)
+		}
+	}
+	if len(seen) != 2 || seen[0] != "Bearer rotated-1" || seen[1] != "Bearer rotated-2" {
+		t.Fatalf("unexpected auth headers: %v", seen)
+	}
+
+	failing, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithTokenSource(func(context.Context) (string, error) { return "", fmt.Errorf("refresh failed") }),
+		WithTransport(transport.New()),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	if _, err := failing.Issues().GetIssue(ctx, "ABC-1"); err == nil {
+		t.Fatalf("expected token source error")
+	}
+	if len(seen) != 2 {
+		t.Fatalf("expected no request when token source fails")
+	}
+}
+
+func TestClientRoutesCloudRequestsToCloudBaseURL(t *testing.T) {
+	t.Parallel()
+
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/ABC-1" {
+			t.Fatalf("unexpected site path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","key":"ABC-1"}`))
+	}))
+	defer site.Close()
+
+	cloud := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ex/jira/cloud-1/jsm/assets/workspace/ws-1/v1/object/42" {
+			t.Fatalf("unexpected cloud path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"42"}`))
+	}))
+	defer cloud.Close()
+
+	client, err := NewClient(
+		WithBaseURL(site.URL),
+		WithCloudBaseURL(cloud.URL),
+		WithAssetsCloudID("cloud-1"),
+		WithAssetsWorkspaceID("ws-1"),
+		WithTransport(transport.New()),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if _, err := client.Issues().GetIssue(context.Background(), "ABC-1"); err != nil {
+		t.Fatalf("GetIssue failed: %v", err)
+	}
+	if _, err := client.Assets().GetObject(context.Background(), "42"); err != nil {
+		t.Fatalf("GetObject failed: %v", err)
+	}
+
+	defaulted, err := NewClient(WithBaseURL(site.URL))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	if got := defaulted.cloudBaseURL.String(); got != DefaultCloudBaseURL {
+		t.Fatalf("unexpected default cloud base URL: %s", got)
+	}
+
+	if _, err := NewClient(WithBaseURL(site.URL), WithCloudBaseURL("not-a-url")); err == nil {
+		t.Fatalf("expected error for invalid cloud base URL")
+	}
+}
+
 func TestNewClientValidatesBaseURL(t *testing.T) {
 	t.Parallel()
 
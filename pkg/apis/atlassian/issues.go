@@ -3,12 +3,25 @@ package atlassian
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
+)
+
+const (
+	defaultBulkUpdateConcurrency = 5
+	// maxBulkFetchIssues is the issue/bulkfetch limit per request.
+	maxBulkFetchIssues = 100
 )
 
 // IssuesService provides Jira issue operations.
@@ -75,6 +88,39 @@ func (s *IssuesService) GetIssue(ctx context.Context, ticketKey string) (*Issue,
 	return &issue, nil
 }
 
+// IssueExists reports whether the issue exists and is visible to the caller,
+// without fetching its fields.
+func (s *IssuesService) IssueExists(ctx context.Context, ticketKey string) (bool, error) {
+	if strings.TrimSpace(ticketKey) == "" {
+		return false, errors.New("atlassian: ticket key is required")
+	}
+
+	query := url.Values{}
+	query.Set("fields", "")
+	path := fmt.Sprintf("/rest/api/3/issue/%s", url.PathEscape(ticketKey))
+	req, err := s.client.newRequest(ctx, http.MethodGet, path, query, nil)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := s.client.transport.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return false, nil
+	case resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices:
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return true, nil
+	default:
+		return false, transport.NewAPIError(resp, 0)
+	}
+}
+
 // CreateIssue creates a new Jira issue.
 func (s *IssuesService) CreateIssue(ctx context.Context, body *CreateIssueRequest) (*CreatedIssue, error) {
 	if body == nil {
@@ -93,6 +139,139 @@ func (s *IssuesService) CreateIssue(ctx context.Context, body *CreateIssueReques
 	return &created, nil
 }
 
+// CreateIssueFromTemplate resolves the template's field names via ListFields,
+// creates the issue and then adds labels and watchers. Unknown or ambiguous
+// field names fail before anything is created. If the issue is created but
+// adding labels or watchers fails, the issue is returned together with the
+// joined errors of the failed steps.
+func (s *IssuesService) CreateIssueFromTemplate(ctx context.Context, tmpl IssueTemplate) (*Issue, error) {
+	if strings.TrimSpace(tmpl.ProjectKey) == "" {
+		return nil, errors.New("atlassian: project key is required")
+	}
+	if strings.TrimSpace(tmpl.IssueType) == "" {
+		return nil, errors.New("atlassian: issue type is required")
+	}
+	if strings.TrimSpace(tmpl.Summary) == "" {
+		return nil, errors.New("atlassian: summary is required")
+	}
+	labels, err := normalizeLabels(tmpl.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]any{
+		"project":   map[string]string{"key": tmpl.ProjectKey},
+		"issuetype": map[string]string{"name": tmpl.IssueType},
+		"summary":   tmpl.Summary,
+	}
+	if len(tmpl.Fields) > 0 {
+		known, err := s.ListFields(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for name, value := range tmpl.Fields {
+			id, err := resolveFieldID(known, name)
+			if err != nil {
+				return nil, err
+			}
+			fields[id] = value
+		}
+	}
+
+	created, err := s.CreateIssue(ctx, &CreateIssueRequest{Fields: fields})
+	if err != nil {
+		return nil, err
+	}
+	issue := &Issue{ID: created.ID, Key: created.Key}
+
+	var errs []error
+	if len(labels) > 0 {
+		if err := s.ManageTags(ctx, issue.Key, labels, nil, nil); err != nil {
+			errs = append(errs, fmt.Errorf("atlassian: add labels: %w", err))
+		}
+	}
+	for _, accountID := range tmpl.Watchers {
+		if strings.TrimSpace(accountID) == "" {
+			continue
+		}
+		if err := s.AddWatcher(ctx, issue.Key, accountID); err != nil {
+			errs = append(errs, fmt.Errorf("atlassian: add watcher %s: %w", accountID, err))
+		}
+	}
+	return issue, errors.Join(errs...)
+}
+
+// resolveFieldID maps a field ID or case-insensitive field name to its ID.
+func resolveFieldID(fields []Field, nameOrID string) (string, error) {
+	var matches []string
+	for _, field := range fields {
+		if field.ID == nameOrID {
+			return field.ID, nil
+		}
+		if strings.EqualFold(field.Name, strings.TrimSpace(nameOrID)) {
+			matches = append(matches, field.ID)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("atlassian: unknown field %q", nameOrID)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("atlassian: field name %q is ambiguous (%s)", nameOrID, strings.Join(matches, ", "))
+	}
+}
+
+// ListFields returns all system and custom Jira fields visible to the caller.
+func (s *IssuesService) ListFields(ctx context.Context) ([]Field, error) {
+	req, err := s.client.newRequest(ctx, http.MethodGet, "/rest/api/3/field", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []Field
+	if err := s.client.transport.DoJSON(req, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// AddWatcher adds the user with accountID as a watcher of the issue.
+func (s *IssuesService) AddWatcher(ctx context.Context, ticketKey, accountID string) error {
+	if strings.TrimSpace(ticketKey) == "" {
+		return errors.New("atlassian: ticket key is required")
+	}
+	if strings.TrimSpace(accountID) == "" {
+		return errors.New("atlassian: account ID is required")
+	}
+
+	path := fmt.Sprintf("/rest/api/3/issue/%s/watchers", url.PathEscape(ticketKey))
+	req, err := s.client.newRequest(ctx, http.MethodPost, path, nil, accountID)
+	if err != nil {
+		return err
+	}
+	return s.client.doNoResponseBody(req)
+}
+
+// GetEditMeta returns fields the caller can edit on the issue.
+func (s *IssuesService) GetEditMeta(ctx context.Context, ticketKey string) (*EditMeta, error) {
+	if strings.TrimSpace(ticketKey) == "" {
+		return nil, errors.New("atlassian: ticket key is required")
+	}
+
+	path := fmt.Sprintf("/rest/api/3/issue/%s/editmeta", url.PathEscape(ticketKey))
+	req, err := s.client.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var meta EditMeta
+	if err := s.client.transport.DoJSON(req, &meta); err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
 // SaveStoryPoints updates Jira custom field for story points.
 func (s *IssuesService) SaveStoryPoints(ctx context.Context, ticketKey string, points float64, fieldID string) error {
 	if strings.TrimSpace(ticketKey) == "" {
@@ -174,6 +353,132 @@ func (s *IssuesService) FindIssues(ctx context.Context, jql string, opts *FindIs
 	}
 }
 
+// issueNotReturnedMessage is reported for bulkfetch issues Jira left out.
+const issueNotReturnedMessage = "issue does not exist or you do not have permission to see it"
+
+// GetIssuesBulk fetches issues by ID or key via issue/bulkfetch, splitting
+// requests into batches of 100. Only Fields and Expand of opts are used.
+// Issues Jira could not return are reported as *IssueFetchError alongside the
+// issues that were found.
+func (s *IssuesService) GetIssuesBulk(ctx context.Context, keys []string, opts *FindIssuesOptions) ([]Issue, error) {
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if trimmed := strings.TrimSpace(key); trimmed != "" {
+			ids = append(ids, trimmed)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, errors.New("atlassian: issue keys are required")
+	}
+
+	if opts == nil {
+		opts = &FindIssuesOptions{}
+	}
+	fields := opts.Fields
+	if len(fields) == 0 {
+		fields = []string{"*all"}
+	}
+
+	var (
+		issues  []Issue
+		missing map[string]string
+	)
+	for start := 0; start < len(ids); start += maxBulkFetchIssues {
+		end := min(start+maxBulkFetchIssues, len(ids))
+
+		payload := map[string]any{
+			"issueIdsOrKeys": ids[start:end],
+			"fields":         fields,
+		}
+		if len(opts.Expand) > 0 {
+			payload["expand"] = opts.Expand
+		}
+
+		req, err := s.client.newRequest(ctx, http.MethodPost, "/rest/api/3/issue/bulkfetch", nil, payload)
+		if err != nil {
+			return nil, err
+		}
+
+		var page struct {
+			Issues      []Issue `json:"issues"`
+			IssueErrors []struct {
+				ID           string `json:"id"`
+				ErrorMessage string `json:"errorMessage"`
+			} `json:"issueErrors"`
+		}
+		if err := s.client.transport.DoJSON(req, &page); err != nil {
+			return nil, err
+		}
+
+		issues = append(issues, page.Issues...)
+		returned := make(map[string]struct{}, 2*len(page.Issues))
+		for _, issue := range page.Issues {
+			returned[issue.ID] = struct{}{}
+			returned[strings.ToUpper(issue.Key)] = struct{}{}
+		}
+		for _, issueErr := range page.IssueErrors {
+			if missing == nil {
+				missing = make(map[string]string)
+			}
+			missing[issueErr.ID] = issueErr.ErrorMessage
+		}
+		// Jira silently omits issues that do not exist or are not visible.
+		for _, id := range ids[start:end] {
+			if _, ok := returned[strings.ToUpper(id)]; ok {
+				continue
+			}
+			if _, ok := missing[id]; ok {
+				continue
+			}
+			if missing == nil {
+				missing = make(map[string]string)
+			}
+			missing[id] = issueNotReturnedMessage
+		}
+	}
+
+	if len(missing) > 0 {
+		return issues, &IssueFetchError{Missing: missing}
+	}
+	return issues, nil
+}
+
+// ValidateJQL checks JQL syntax without running the search. It returns an
+// error carrying Jira's parse messages when the query is invalid.
+func (s *IssuesService) ValidateJQL(ctx context.Context, jql string) error {
+	if strings.TrimSpace(jql) == "" {
+		return errors.New("atlassian: jql is required")
+	}
+
+	query := url.Values{}
+	query.Set("validation", "strict")
+	payload := map[string]any{"queries": []string{jql}}
+
+	req, err := s.client.newRequest(ctx, http.MethodPost, "/rest/api/3/jql/parse", query, payload)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Queries []struct {
+			Query  string   `json:"query"`
+			Errors []string `json:"errors"`
+		} `json:"queries"`
+	}
+	if err := s.client.transport.DoJSON(req, &response); err != nil {
+		return err
+	}
+
+	var messages []string
+	for _, parsed := range response.Queries {
+		messages = append(messages, parsed.Errors...)
+	}
+	if len(messages) > 0 {
+		return fmt.Errorf("atlassian: invalid jql: %s", strings.Join(messages, "; "))
+	}
+	return nil
+}
+
 // UpdateIssue edits a Jira issue fields and/or applies update operations.
 func (s *IssuesService) UpdateIssue(ctx context.Context, ticketKey string, body *UpdateIssueRequest, opts *UpdateIssueOptions) (*Issue, error) {
 	if strings.TrimSpace(ticketKey) == "" {
@@ -221,6 +526,63 @@ func (s *IssuesService) UpdateIssue(ctx context.Context, ticketKey string, body
 	return nil, s.client.doNoResponseBody(req)
 }
 
+// BulkUpdateIssues sets the same fields on many issues using bounded
+// concurrency. Per-issue failures are reported in the result, not as error.
+func (s *IssuesService) BulkUpdateIssues(ctx context.Context, keys []string, fields map[string]any, opts *BulkUpdateOptions) (*BulkUpdateResult, error) {
+	trimmed := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key = strings.TrimSpace(key); key != "" {
+			trimmed = append(trimmed, key)
+		}
+	}
+	if len(trimmed) == 0 {
+		return nil, errors.New("atlassian: at least one ticket key is required")
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("atlassian: fields are required")
+	}
+
+	concurrency := defaultBulkUpdateConcurrency
+	var updateOpts *UpdateIssueOptions
+	if opts != nil {
+		if opts.Concurrency > 0 {
+			concurrency = opts.Concurrency
+		}
+		if opts.NotifyUsers != nil {
+			updateOpts = &UpdateIssueOptions{NotifyUsers: opts.NotifyUsers}
+		}
+	}
+
+	errs := make([]error, len(trimmed))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, key := range trimmed {
+		wg.Add(1)
+		go func(i int, key string) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+			defer func() { <-sem }()
+			_, errs[i] = s.UpdateIssue(ctx, key, &UpdateIssueRequest{Fields: fields}, updateOpts)
+		}(i, key)
+	}
+	wg.Wait()
+
+	result := &BulkUpdateResult{Failed: make(map[string]error)}
+	for i, key := range trimmed {
+		if errs[i] != nil {
+			result.Failed[key] = errs[i]
+			continue
+		}
+		result.Succeeded = append(result.Succeeded, key)
+	}
+	return result, nil
+}
+
 // GetTransitions returns workflow transitions available on the issue.
 func (s *IssuesService) GetTransitions(ctx context.Context, ticketKey string, opts *GetTransitionsOptions) (*TransitionsList, error) {
 	if strings.TrimSpace(ticketKey) == "" {
@@ -297,31 +659,91 @@ func (s *IssuesService) DoTransition(ctx context.Context, ticketKey string, body
 	return s.client.doNoResponseBody(req)
 }
 
+// GetIssueChangelog returns issue change history with optional pagination.
+func (s *IssuesService) GetIssueChangelog(ctx context.Context, ticketKey string, opts *ChangelogOptions) (*ChangelogResult, error) {
+	if strings.TrimSpace(ticketKey) == "" {
+		return nil, errors.New("atlassian: ticket key is required")
+	}
+	if opts == nil {
+		opts = &ChangelogOptions{}
+	}
+
+	path := fmt.Sprintf("/rest/api/3/issue/%s/changelog", url.PathEscape(ticketKey))
+	startAt := opts.StartAt
+	combined := make([]ChangelogEntry, 0)
+
+	for {
+		params := url.Values{}
+		if startAt > 0 {
+			params.Set("startAt", strconv.Itoa(startAt))
+		}
+		if opts.MaxResults > 0 {
+			params.Set("maxResults", strconv.Itoa(opts.MaxResults))
+		}
+
+		req, err := s.client.newRequest(ctx, http.MethodGet, path, params, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page ChangelogResult
+		if err := s.client.transport.DoJSON(req, &page); err != nil {
+			return nil, err
+		}
+		if !opts.FetchAll {
+			return &page, nil
+		}
+
+		combined = append(combined, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			page.Values = combined
+			page.StartAt = opts.StartAt
+			page.NextPage = ""
+			page.IsLast = true
+			return &page, nil
+		}
+		startAt = page.StartAt + len(page.Values)
+	}
+}
+
+// ErrNoLabelChanges is returned by ManageTags when add, remove and replace
+// contain no labels after trimming, so no request is sent.
+var ErrNoLabelChanges = errors.New("atlassian: no label changes requested")
+
 // ManageTags updates Jira labels via add/remove or full replace.
+// Labels are trimmed and deduplicated; labels containing whitespace are
+// rejected because Jira does not allow them.
 func (s *IssuesService) ManageTags(ctx context.Context, ticketKey string, add, remove, replace []string) error {
 	if strings.TrimSpace(ticketKey) == "" {
 		return errors.New("atlassian: ticket key is required")
 	}
 
+	add, err := normalizeLabels(add)
+	if err != nil {
+		return err
+	}
+	remove, err = normalizeLabels(remove)
+	if err != nil {
+		return err
+	}
+	replace, err = normalizeLabels(replace)
+	if err != nil {
+		return err
+	}
+
 	payload := map[string]any{}
 	if len(replace) > 0 {
 		payload["fields"] = map[string]any{"labels": replace}
 	} else {
 		ops := make([]map[string]string, 0, len(add)+len(remove))
 		for _, label := range add {
-			if strings.TrimSpace(label) == "" {
-				continue
-			}
 			ops = append(ops, map[string]string{"add": label})
 		}
 		for _, label := range remove {
-			if strings.TrimSpace(label) == "" {
-				continue
-			}
 			ops = append(ops, map[string]string{"remove": label})
 		}
 		if len(ops) == 0 {
-			return nil
+			return ErrNoLabelChanges
 		}
 		payload["update"] = map[string]any{"labels": ops}
 	}
@@ -334,7 +756,69 @@ func (s *IssuesService) ManageTags(ctx context.Context, ticketKey string, add, r
 	return s.client.doNoResponseBody(req)
 }
 
+// SuggestLabels returns existing Jira labels matching query, for autocomplete.
+func (s *IssuesService) SuggestLabels(ctx context.Context, query string, opts *LabelSuggestOptions) ([]string, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, errors.New("atlassian: label query is required")
+	}
+	if opts == nil {
+		opts = &LabelSuggestOptions{}
+	}
+
+	params := url.Values{}
+	params.Set("query", query)
+
+	req, err := s.client.newRequest(ctx, http.MethodGet, "/rest/api/1.0/labels/suggest", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Suggestions []struct {
+			Label string `json:"label"`
+		} `json:"suggestions"`
+	}
+	if err := s.client.transport.DoJSON(req, &response); err != nil {
+		return nil, err
+	}
+
+	labels := make([]string, 0, len(response.Suggestions))
+	for _, suggestion := range response.Suggestions {
+		if suggestion.Label == "" {
+			continue
+		}
+		labels = append(labels, suggestion.Label)
+		if opts.MaxResults > 0 && len(labels) == opts.MaxResults {
+			break
+		}
+	}
+	return labels, nil
+}
+
+// normalizeLabels trims labels, drops empty and duplicate ones and rejects
+// labels containing whitespace.
+func normalizeLabels(labels []string) ([]string, error) {
+	out := make([]string, 0, len(labels))
+	seen := make(map[string]struct{}, len(labels))
+	for _, label := range labels {
+		label = strings.TrimSpace(label)
+		if label == "" {
+			continue
+		}
+		if strings.ContainsFunc(label, unicode.IsSpace) {
+			return nil, fmt.Errorf("atlassian: label %q contains whitespace", label)
+		}
+		if _, ok := seen[label]; ok {
+			continue
+		}
+		seen[label] = struct{}{}
+		out = append(out, label)
+	}
+	return out, nil
+}
+
 // CreateComment creates Jira comment; internal=true adds JSM internal property.
+// The text is converted to ADF with TextToADF.
 func (s *IssuesService) CreateComment(ctx context.Context, ticketKey, text string, internal bool, opts ...CommentOption) (*Comment, error) {
 	if strings.TrimSpace(ticketKey) == "" {
 		return nil, errors.New("atlassian: ticket key is required")
@@ -342,8 +826,20 @@ func (s *IssuesService) CreateComment(ctx context.Context, ticketKey, text strin
 	if strings.TrimSpace(text) == "" {
 		return nil, errors.New("atlassian: comment text is required")
 	}
+	return s.CreateCommentADF(ctx, ticketKey, TextToADF(text), internal, opts...)
+}
 
-	payload := map[string]any{"body": TextToADF(text)}
+// CreateCommentADF creates Jira comment from a pre-built ADF document
+// (see ADFDoc); internal=true adds JSM internal property.
+func (s *IssuesService) CreateCommentADF(ctx context.Context, ticketKey string, body json.RawMessage, internal bool, opts ...CommentOption) (*Comment, error) {
+	if strings.TrimSpace(ticketKey) == "" {
+		return nil, errors.New("atlassian: ticket key is required")
+	}
+	if len(body) == 0 {
+		return nil, errors.New("atlassian: comment body is required")
+	}
+
+	payload := map[string]any{"body": body}
 	if internal {
 		payload["properties"] = []map[string]any{{
 			"key":   "sd.public.comment",
@@ -370,6 +866,56 @@ func (s *IssuesService) CreateComment(ctx context.Context, ticketKey, text strin
 	return &comment, nil
 }
 
+// GetComment returns a single issue comment by ID.
+func (s *IssuesService) GetComment(ctx context.Context, ticketKey, commentID string) (*Comment, error) {
+	if strings.TrimSpace(ticketKey) == "" {
+		return nil, errors.New("atlassian: ticket key is required")
+	}
+	if strings.TrimSpace(commentID) == "" {
+		return nil, errors.New("atlassian: comment ID is required")
+	}
+
+	path := fmt.Sprintf("/rest/api/3/issue/%s/comment/%s", url.PathEscape(ticketKey), url.PathEscape(commentID))
+	req, err := s.client.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var comment Comment
+	if err := s.client.transport.DoJSON(req, &comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// CreateServiceDeskComment adds a comment to a JSM customer request via the
+// servicedeskapi. public=false creates an internal note hidden from customers.
+func (s *IssuesService) CreateServiceDeskComment(ctx context.Context, requestKey, text string, public bool) (*Comment, error) {
+	if strings.TrimSpace(requestKey) == "" {
+		return nil, errors.New("atlassian: request key is required")
+	}
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("atlassian: comment text is required")
+	}
+
+	payload := map[string]any{
+		"body":   text,
+		"public": public,
+	}
+
+	path := fmt.Sprintf("/rest/servicedeskapi/request/%s/comment", url.PathEscape(requestKey))
+	req, err := s.client.newRequest(ctx, http.MethodPost, path, nil, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var comment Comment
+	if err := s.client.transport.DoJSON(req, &comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
 // AddAttachment uploads attachment to Jira issue.
 func (s *IssuesService) AddAttachment(ctx context.Context, ticketKey, filename string, content []byte) (*Attachment, error) {
 	if strings.TrimSpace(ticketKey) == "" {
@@ -408,3 +954,17 @@ func (s *IssuesService) AddAttachment(ctx context.Context, ticketKey, filename s
 	}
 	return &attachments[0], nil
 }
+
+// DeleteAttachment deletes Jira attachment by ID.
+func (s *IssuesService) DeleteAttachment(ctx context.Context, attachmentID string) error {
+	if strings.TrimSpace(attachmentID) == "" {
+		return errors.New("atlassian: attachment ID is required")
+	}
+
+	path := fmt.Sprintf("/rest/api/3/attachment/%s", url.PathEscape(attachmentID))
+	req, err := s.client.newRequest(ctx, http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return err
+	}
+	return s.client.doNoResponseBody(req)
+}
@@ -3,9 +3,14 @@ package atlassian
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
 )
@@ -102,6 +107,56 @@ func TestManageTagsReplace(t *testing.T) {
 	}
 }
 
+func TestManageTagsDedupesAddRemove(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Update struct {
+				Labels []map[string]string `json:"labels"`
+			} `json:"update"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		ops := payload.Update.Labels
+		if len(ops) != 3 || ops[0]["add"] != "backend" || ops[1]["add"] != "urgent" || ops[2]["remove"] != "triage" {
+			t.Fatalf("unexpected label ops: %v", ops)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	err = client.Issues().ManageTags(context.Background(), "ABC-1", []string{" backend", "urgent", "backend ", ""}, []string{"triage", "triage"}, nil)
+	if err != nil {
+		t.Fatalf("ManageTags: %v", err)
+	}
+}
+
+func TestManageTagsValidation(t *testing.T) {
+	t.Parallel()
+
+	client, err := NewClient(WithBaseURL("http://127.0.0.1:0"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	err = client.Issues().ManageTags(context.Background(), "ABC-1", []string{" "}, nil, nil)
+	if !errors.Is(err, ErrNoLabelChanges) {
+		t.Fatalf("expected ErrNoLabelChanges, got %v", err)
+	}
+
+	err = client.Issues().ManageTags(context.Background(), "ABC-1", []string{"needs review"}, nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "whitespace") {
+		t.Fatalf("expected whitespace label error, got %v", err)
+	}
+}
+
 func TestCreateIssue(t *testing.T) {
 	t.Parallel()
 
@@ -516,3 +571,740 @@ func TestTransitionsValidation(t *testing.T) {
 	}
 }
 
+
+func TestBulkUpdateIssues(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, maxInFlight int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			seen := atomic.LoadInt32(&maxInFlight)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		if r.Method != http.MethodPut {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("decode payload: %v", err)
+		}
+		fields, _ := payload["fields"].(map[string]any)
+		if fields["customfield_10016"] != float64(3) {
+			t.Errorf("unexpected fields: %v", payload["fields"])
+		}
+
+		if r.URL.Path == "/rest/api/3/issue/ABC-2" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errorMessages":[],"errors":{"customfield_10016":"Field cannot be set"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	result, err := client.Issues().BulkUpdateIssues(context.Background(),
+		[]string{"ABC-1", "ABC-2", " ", "ABC-3", "ABC-4"},
+		map[string]any{"customfield_10016": 3},
+		&BulkUpdateOptions{Concurrency: 2},
+	)
+	if err != nil {
+		t.Fatalf("BulkUpdateIssues failed: %v", err)
+	}
+	if len(result.Succeeded) != 3 || result.Succeeded[0] != "ABC-1" || result.Succeeded[2] != "ABC-4" {
+		t.Fatalf("unexpected succeeded keys: %v", result.Succeeded)
+	}
+	if len(result.Failed) != 1 {
+		t.Fatalf("unexpected failures: %v", result.Failed)
+	}
+	var apiErr *transport.APIError
+	if !errors.As(result.Failed["ABC-2"], &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 APIError for ABC-2, got %v", result.Failed["ABC-2"])
+	}
+	if got := atomic.LoadInt32(&maxInFlight); got > 2 {
+		t.Fatalf("expected at most 2 concurrent requests, got %d", got)
+	}
+
+	if _, err := client.Issues().BulkUpdateIssues(context.Background(), []string{" "}, map[string]any{"x": 1}, nil); err == nil {
+		t.Fatalf("expected error for empty keys")
+	}
+}
+
+func TestValidateJQL(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/api/3/jql/parse" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("validation") != "strict" {
+			t.Fatalf("unexpected validation: %q", r.URL.Query().Get("validation"))
+		}
+		var payload struct {
+			Queries []string `json:"queries"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		if len(payload.Queries) != 1 {
+			t.Fatalf("unexpected queries: %v", payload.Queries)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if payload.Queries[0] == "project = ABC" {
+			_, _ = w.Write([]byte(`{"queries":[{"query":"project = ABC","structure":{}}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"queries":[{"query":"projct = ABC","errors":["Field 'projct' does not exist or you do not have permission to view it."]}]}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if err := client.Issues().ValidateJQL(context.Background(), "project = ABC"); err != nil {
+		t.Fatalf("expected valid JQL, got %v", err)
+	}
+
+	err = client.Issues().ValidateJQL(context.Background(), "projct = ABC")
+	if err == nil {
+		t.Fatalf("expected error for invalid JQL")
+	}
+	if !strings.Contains(err.Error(), "Field 'projct' does not exist") {
+		t.Fatalf("error does not contain Jira message: %v", err)
+	}
+}
+
+func TestGetIssueChangelogFetchAll(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/rest/api/3/issue/ABC-1/changelog" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("maxResults") != "1" {
+			t.Fatalf("unexpected maxResults: %q", r.URL.Query().Get("maxResults"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":1,"total":2,"isLast":false,"values":[{"id":"100","author":{"accountId":"acc-1","displayName":"Ann"},"created":"2024-01-01T10:00:00.000+0000","items":[{"field":"status","fieldtype":"jira","fromString":"Open","toString":"In Progress"}]}]}`))
+		case "1":
+			_, _ = w.Write([]byte(`{"startAt":1,"maxResults":1,"total":2,"isLast":true,"values":[{"id":"101","author":{"accountId":"acc-2"},"created":"2024-01-02T10:00:00.000+0000","items":[{"field":"labels","fromString":"","toString":"incident"}]}]}`))
+		default:
+			t.Fatalf("unexpected startAt: %q", r.URL.Query().Get("startAt"))
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	result, err := client.Issues().GetIssueChangelog(context.Background(), "ABC-1", &ChangelogOptions{MaxResults: 1, FetchAll: true})
+	if err != nil {
+		t.Fatalf("GetIssueChangelog failed: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+	if len(result.Values) != 2 || !result.IsLast {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	first := result.Values[0]
+	if first.ID != "100" || first.Author.AccountID != "acc-1" || first.Created == "" {
+		t.Fatalf("unexpected first entry: %+v", first)
+	}
+	if len(first.Items) != 1 || first.Items[0].Field != "status" || first.Items[0].FromString != "Open" || first.Items[0].ToString != "In Progress" {
+		t.Fatalf("unexpected first entry items: %+v", first.Items)
+	}
+	if result.Values[1].Items[0].ToString != "incident" {
+		t.Fatalf("unexpected second entry: %+v", result.Values[1])
+	}
+}
+
+func TestCreateCommentADF(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/api/3/issue/ABC-1/comment" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var payload struct {
+			Body struct {
+				Type    string `json:"type"`
+				Version int    `json:"version"`
+				Content []struct {
+					Type    string `json:"type"`
+					Content []struct {
+						Text string `json:"text"`
+					} `json:"content"`
+				} `json:"content"`
+			} `json:"body"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		if payload.Body.Type != "doc" || payload.Body.Version != 1 {
+			t.Fatalf("body is not an ADF doc: %+v", payload.Body)
+		}
+		if len(payload.Body.Content) != 1 || payload.Body.Content[0].Type != "paragraph" || payload.Body.Content[0].Content[0].Text != "Investigating" {
+			t.Fatalf("unexpected ADF content: %+v", payload.Body.Content)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"10"}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	comment, err := client.Issues().CreateCommentADF(context.Background(), "ABC-1", ADFDoc(ADFParagraph("Investigating")), false)
+	if err != nil {
+		t.Fatalf("CreateCommentADF failed: %v", err)
+	}
+	if comment.ID != "10" {
+		t.Fatalf("unexpected comment: %+v", comment)
+	}
+
+	if _, err := client.Issues().CreateCommentADF(context.Background(), "ABC-1", nil, false); err == nil {
+		t.Fatalf("expected error for empty body")
+	}
+}
+
+func TestIssueStandardFieldHelpers(t *testing.T) {
+	t.Parallel()
+
+	var issue Issue
+	raw := `{"id":"10042","key":"OPS-42","fields":{
+		"summary":"Checkout latency above SLO",
+		"status":{"self":"https://example.atlassian.net/rest/api/3/status/3","id":"3","name":"In Progress","statusCategory":{"id":4,"key":"indeterminate","name":"In Progress"}},
+		"priority":{"self":"https://example.atlassian.net/rest/api/3/priority/2","id":"2","name":"High","iconUrl":"https://example.atlassian.net/images/icons/priorities/high.svg"},
+		"assignee":{"accountId":"5b10ac8d82e05b22cc7d4ef5","displayName":"Mia Krystof","active":true,"timeZone":"Europe/Berlin"}
+	}}`
+	if err := json.Unmarshal([]byte(raw), &issue); err != nil {
+		t.Fatalf("unmarshal issue: %v", err)
+	}
+
+	if status, ok := issue.Status(); !ok || status != "In Progress" {
+		t.Fatalf("unexpected status: %q ok=%v", status, ok)
+	}
+	if priority, ok := issue.Priority(); !ok || priority != "High" {
+		t.Fatalf("unexpected priority: %q ok=%v", priority, ok)
+	}
+	if assignee, ok := issue.AssigneeAccountID(); !ok || assignee != "5b10ac8d82e05b22cc7d4ef5" {
+		t.Fatalf("unexpected assignee: %q ok=%v", assignee, ok)
+	}
+
+	var unassigned Issue
+	if err := json.Unmarshal([]byte(`{"key":"OPS-43","fields":{"status":{"name":"Open"},"assignee":null}}`), &unassigned); err != nil {
+		t.Fatalf("unmarshal issue: %v", err)
+	}
+	if _, ok := unassigned.AssigneeAccountID(); ok {
+		t.Fatalf("expected unassigned issue to report false")
+	}
+	if _, ok := unassigned.Priority(); ok {
+		t.Fatalf("expected missing priority to report false")
+	}
+	if status, ok := unassigned.Status(); !ok || status != "Open" {
+		t.Fatalf("unexpected status: %q ok=%v", status, ok)
+	}
+}
+
+func TestIssueFieldAccessors(t *testing.T) {
+	t.Parallel()
+
+	var issue Issue
+	raw := `{"id":"1","key":"ABC-1","fields":{"summary":"DB outage","customfield_10016":5,"customfield_10020":"Team A","assignee":null,"status":{"name":"Open"}}}`
+	if err := json.Unmarshal([]byte(raw), &issue); err != nil {
+		t.Fatalf("unmarshal issue: %v", err)
+	}
+
+	if summary, ok := issue.GetStringField("summary"); !ok || summary != "DB outage" {
+		t.Fatalf("unexpected summary: %q ok=%v", summary, ok)
+	}
+	if team, ok := issue.GetStringField("customfield_10020"); !ok || team != "Team A" {
+		t.Fatalf("unexpected custom field: %q ok=%v", team, ok)
+	}
+	if points, ok := issue.GetField("customfield_10016"); !ok || string(points) != "5" {
+		t.Fatalf("unexpected raw custom field: %s ok=%v", points, ok)
+	}
+	if _, ok := issue.GetStringField("customfield_10016"); ok {
+		t.Fatalf("expected non-string field to report false")
+	}
+	if _, ok := issue.GetField("customfield_99999"); ok {
+		t.Fatalf("expected missing field to report false")
+	}
+	if _, ok := issue.GetField("assignee"); ok {
+		t.Fatalf("expected null field to report false")
+	}
+
+	var fields struct {
+		Status struct {
+			Name string `json:"name"`
+		} `json:"status"`
+	}
+	if err := issue.DecodeFields(&fields); err != nil {
+		t.Fatalf("DecodeFields failed: %v", err)
+	}
+	if fields.Status.Name != "Open" {
+		t.Fatalf("unexpected status: %+v", fields.Status)
+	}
+
+	copied := issue
+	issue.Fields = json.RawMessage(`{"summary":"DB outage resolved"}`)
+	if summary, ok := issue.GetStringField("summary"); !ok || summary != "DB outage resolved" {
+		t.Fatalf("expected reassigned fields to be read, got %q ok=%v", summary, ok)
+	}
+	if summary, ok := copied.GetStringField("summary"); !ok || summary != "DB outage" {
+		t.Fatalf("expected copy to keep its own fields, got %q ok=%v", summary, ok)
+	}
+
+	empty := Issue{Key: "ABC-2"}
+	if _, ok := empty.GetStringField("summary"); ok {
+		t.Fatalf("expected missing field on issue without fields")
+	}
+	if err := empty.DecodeFields(&fields); err == nil {
+		t.Fatalf("expected error decoding empty fields")
+	}
+}
+
+func TestGetEditMeta(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/rest/api/3/issue/ABC-1/editmeta" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"fields":{
+			"summary":{"required":true,"schema":{"type":"string","system":"summary"},"name":"Summary","key":"summary","operations":["set"]},
+			"priority":{"required":false,"schema":{"type":"priority","system":"priority"},"name":"Priority","key":"priority","operations":["set"],"allowedValues":[{"id":"1","name":"High"},{"id":"2","name":"Low"}]},
+			"customfield_10016":{"required":false,"schema":{"type":"number","custom":"com.atlassian.jira.plugin.system.customfieldtypes:float","customId":10016},"name":"Story Points","key":"customfield_10016","operations":["set"]}
+		}}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	meta, err := client.Issues().GetEditMeta(context.Background(), "ABC-1")
+	if err != nil {
+		t.Fatalf("GetEditMeta failed: %v", err)
+	}
+	if len(meta.Fields) != 3 {
+		t.Fatalf("unexpected fields: %+v", meta.Fields)
+	}
+	summary := meta.Fields["summary"]
+	if summary.Name != "Summary" || !summary.Required || summary.Schema.Type != "string" {
+		t.Fatalf("unexpected summary meta: %+v", summary)
+	}
+	if points := meta.Fields["customfield_10016"]; points.Schema.CustomID != 10016 || points.Required {
+		t.Fatalf("unexpected story points meta: %+v", points)
+	}
+	if len(meta.Fields["priority"].AllowedValues) != 2 {
+		t.Fatalf("unexpected priority allowed values: %+v", meta.Fields["priority"].AllowedValues)
+	}
+	if !meta.IsFieldEditable("customfield_10016") {
+		t.Fatalf("expected story points to be editable")
+	}
+	if meta.IsFieldEditable("resolution") {
+		t.Fatalf("expected resolution to be read-only")
+	}
+}
+
+func TestIssueExists(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.URL.Query()["fields"]; !ok || r.URL.Query().Get("fields") != "" {
+			t.Fatalf("expected empty fields param, got %q", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/issue/ABC-1":
+			_, _ = w.Write([]byte(`{"id":"1","key":"ABC-1","fields":{}}`))
+		case "/rest/api/3/issue/ABC-404":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorMessages":["Issue does not exist or you do not have permission to see it."]}`))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	exists, err := client.Issues().IssueExists(context.Background(), "ABC-1")
+	if err != nil || !exists {
+		t.Fatalf("expected ABC-1 to exist, got exists=%v err=%v", exists, err)
+	}
+	exists, err = client.Issues().IssueExists(context.Background(), "ABC-404")
+	if err != nil || exists {
+		t.Fatalf("expected ABC-404 to be missing, got exists=%v err=%v", exists, err)
+	}
+	if _, err := client.Issues().IssueExists(context.Background(), "ABC-401"); err == nil {
+		t.Fatalf("expected error for 401")
+	}
+}
+
+func TestCreateServiceDeskComment(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/servicedeskapi/request/SD-7/comment" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		if payload["body"] != "Internal note" {
+			t.Fatalf("unexpected body: %v", payload["body"])
+		}
+		if public, ok := payload["public"].(bool); !ok || public {
+			t.Fatalf("expected public=false, got %v", payload["public"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"1000","body":"Internal note","public":false}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	comment, err := client.Issues().CreateServiceDeskComment(context.Background(), "SD-7", "Internal note", false)
+	if err != nil {
+		t.Fatalf("CreateServiceDeskComment failed: %v", err)
+	}
+	if comment.ID != "1000" || comment.Public == nil || *comment.Public {
+		t.Fatalf("unexpected comment: %+v", comment)
+	}
+
+	if _, err := client.Issues().CreateServiceDeskComment(context.Background(), " ", "x", true); err == nil {
+		t.Fatalf("expected error for empty request key")
+	}
+}
+
+func TestDeleteAttachment(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		switch r.URL.Path {
+		case "/rest/api/3/attachment/10001":
+			w.WriteHeader(http.StatusNoContent)
+		case "/rest/api/3/attachment/10002":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errorMessages":["You do not have permission to delete attachments"]}`))
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if err := client.Issues().DeleteAttachment(context.Background(), "10001"); err != nil {
+		t.Fatalf("DeleteAttachment failed: %v", err)
+	}
+
+	err = client.Issues().DeleteAttachment(context.Background(), "10002")
+	var apiErr *transport.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403 APIError, got %v", err)
+	}
+
+	if err := client.Issues().DeleteAttachment(context.Background(), " "); err == nil {
+		t.Fatalf("expected error for empty attachment ID")
+	}
+}
+
+func TestGetIssuesBulkReportsMissing(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/api/3/issue/bulkfetch" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var payload struct {
+			IssueIDsOrKeys []string `json:"issueIdsOrKeys"`
+			Fields         []string `json:"fields"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		if strings.Join(payload.IssueIDsOrKeys, ",") != "abc-1,2,ABC-404,10099" {
+			t.Fatalf("unexpected keys: %v", payload.IssueIDsOrKeys)
+		}
+		if strings.Join(payload.Fields, ",") != "summary,status" {
+			t.Fatalf("unexpected fields: %v", payload.Fields)
+		}
+		// Missing issues are omitted; issueErrors only carries retriable failures.
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"issues":[{"id":"1","key":"ABC-1"},{"id":"2","key":"ABC-2"}],"issueErrors":[{"id":"10099","errorMessage":"Timed out fetching issue."}]}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	issues, err := client.Issues().GetIssuesBulk(context.Background(), []string{"abc-1", " ", "2", "ABC-404", "10099"}, &FindIssuesOptions{
+		Fields: []string{"summary", "status"},
+	})
+	if len(issues) != 2 || issues[0].Key != "ABC-1" || issues[1].Key != "ABC-2" {
+		t.Fatalf("unexpected issues: %+v", issues)
+	}
+	var fetchErr *IssueFetchError
+	if !errors.As(err, &fetchErr) {
+		t.Fatalf("expected IssueFetchError, got %v", err)
+	}
+	if len(fetchErr.Missing) != 2 || fetchErr.Missing["ABC-404"] == "" || fetchErr.Missing["10099"] != "Timed out fetching issue." {
+		t.Fatalf("unexpected missing issues: %v", fetchErr.Missing)
+	}
+	if !strings.Contains(err.Error(), "ABC-404") {
+		t.Fatalf("expected error to name missing key, got %q", err.Error())
+	}
+
+	if _, err := client.Issues().GetIssuesBulk(context.Background(), nil, nil); err == nil {
+		t.Fatalf("expected error for empty keys")
+	}
+}
+
+func TestGetComment(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/rest/api/3/issue/ABC-1/comment/10042" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"10042","body":{"type":"doc","version":1,"content":[]}}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	comment, err := client.Issues().GetComment(context.Background(), "ABC-1", "10042")
+	if err != nil {
+		t.Fatalf("GetComment failed: %v", err)
+	}
+	if comment.ID != "10042" || !strings.Contains(string(comment.Body), `"type":"doc"`) {
+		t.Fatalf("unexpected comment: %+v", comment)
+	}
+
+	if _, err := client.Issues().GetComment(context.Background(), "ABC-1", " "); err == nil {
+		t.Fatalf("expected error for empty comment ID")
+	}
+	if _, err := client.Issues().GetComment(context.Background(), "", "10042"); err == nil {
+		t.Fatalf("expected error for empty ticket key")
+	}
+}
+
+func TestSuggestLabels(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/1.0/labels/suggest" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("query") != "back" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"token":"back","suggestions":[{"label":"backend","html":"<b>back</b>end"},{"label":"backlog","html":"<b>back</b>log"},{"label":"backport","html":"<b>back</b>port"}]}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	labels, err := client.Issues().SuggestLabels(context.Background(), "back", nil)
+	if err != nil {
+		t.Fatalf("SuggestLabels failed: %v", err)
+	}
+	if strings.Join(labels, ",") != "backend,backlog,backport" {
+		t.Fatalf("unexpected labels: %v", labels)
+	}
+
+	labels, err = client.Issues().SuggestLabels(context.Background(), "back", &LabelSuggestOptions{MaxResults: 2})
+	if err != nil {
+		t.Fatalf("SuggestLabels failed: %v", err)
+	}
+	if len(labels) != 2 {
+		t.Fatalf("expected 2 labels, got %v", labels)
+	}
+
+	if _, err := client.Issues().SuggestLabels(context.Background(), " ", nil); err == nil {
+		t.Fatalf("expected error for empty query")
+	}
+}
+
+func TestGetIssueWithStubbedRoundTripper(t *testing.T) {
+	t.Parallel()
+
+	stub := transport.WithRoundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if r.Method != http.MethodGet || r.URL.Path != "/rest/api/3/issue/ABC-1" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"id":"10001","key":"ABC-1","fields":{"summary":"Stubbed"}}`)),
+			Request:    r,
+		}, nil
+	})
+
+	client, err := NewClient(WithBaseURL("https://example.atlassian.net"), WithTransport(transport.New(stub)))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	issue, err := client.Issues().GetIssue(context.Background(), "ABC-1")
+	if err != nil {
+		t.Fatalf("GetIssue failed: %v", err)
+	}
+	var fields struct {
+		Summary string `json:"summary"`
+	}
+	if err := issue.DecodeFields(&fields); err != nil {
+		t.Fatalf("decode fields: %v", err)
+	}
+	if issue.Key != "ABC-1" || fields.Summary != "Stubbed" {
+		t.Fatalf("unexpected issue: %+v %+v", issue, fields)
+	}
+}
+
+func TestCreateIssueFromTemplateResolvesFieldNames(t *testing.T) {
+	t.Parallel()
+
+	var watchers []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/field":
+			_, _ = w.Write([]byte(`[{"id":"summary","name":"Summary","custom":false},
+				{"id":"customfield_10016","name":"Story Points","custom":true,"schema":{"type":"number","customId":10016}},
+				{"id":"customfield_10050","name":"Severity","custom":true}]`))
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/3/issue":
+			var payload struct {
+				Fields map[string]any `json:"fields"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode payload: %v", err)
+			}
+			fields := payload.Fields
+			if project, _ := fields["project"].(map[string]any); project["key"] != "OPS" {
+				t.Fatalf("unexpected project: %v", fields["project"])
+			}
+			if issueType, _ := fields["issuetype"].(map[string]any); issueType["name"] != "Incident" {
+				t.Fatalf("unexpected issue type: %v", fields["issuetype"])
+			}
+			if fields["summary"] != "DB outage" || fields["customfield_10016"] != float64(3) {
+				t.Fatalf("unexpected fields: %v", fields)
+			}
+			if severity, _ := fields["customfield_10050"].(map[string]any); severity["value"] != "High" {
+				t.Fatalf("unexpected severity: %v", fields["customfield_10050"])
+			}
+			if _, ok := fields["Story Points"]; ok {
+				t.Fatalf("field name was not resolved: %v", fields)
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"10009","key":"OPS-9"}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/rest/api/3/issue/OPS-9":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/3/issue/OPS-9/watchers":
+			var accountID string
+			if err := json.NewDecoder(r.Body).Decode(&accountID); err != nil {
+				t.Fatalf("decode watcher: %v", err)
+			}
+			watchers = append(watchers, accountID)
+			if accountID == "acc-missing" {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"errorMessages":["user not found"]}`))
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	issue, err := client.Issues().CreateIssueFromTemplate(context.Background(), IssueTemplate{
+		ProjectKey: "OPS",
+		IssueType:  "Incident",
+		Summary:    "DB outage",
+		Fields: map[string]any{
+			"story points": 3,
+			"Severity":     map[string]string{"value": "High"},
+		},
+		Labels:   []string{"incident"},
+		Watchers: []string{"acc-1", "acc-missing"},
+	})
+	if issue == nil || issue.Key != "OPS-9" || issue.ID != "10009" {
+		t.Fatalf("expected created issue, got %+v", issue)
+	}
+	var apiErr *transport.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound || !strings.Contains(err.Error(), "acc-missing") {
+		t.Fatalf("expected watcher error, got %v", err)
+	}
+	if strings.Join(watchers, ",") != "acc-1,acc-missing" {
+		t.Fatalf("unexpected watchers: %v", watchers)
+	}
+
+	_, err = client.Issues().CreateIssueFromTemplate(context.Background(), IssueTemplate{
+		ProjectKey: "OPS",
+		IssueType:  "Incident",
+		Summary:    "DB outage",
+		Fields:     map[string]any{"Impact": "high"},
+	})
+	if err == nil || !strings.Contains(err.Error(), `unknown field "Impact"`) {
+		t.Fatalf("expected unknown field error, got %v", err)
+	}
+}
@@ -2,6 +2,10 @@ package atlassian
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 )
 
@@ -12,6 +16,81 @@ type Issue struct {
 	Fields json.RawMessage `json:"fields,omitempty"`
 }
 
+// DecodeFields unmarshals Fields into v.
+func (i *Issue) DecodeFields(v any) error {
+	if len(i.Fields) == 0 {
+		return errors.New("atlassian: issue has no fields")
+	}
+	if err := json.Unmarshal(i.Fields, v); err != nil {
+		return fmt.Errorf("atlassian: decode issue fields: %w", err)
+	}
+	return nil
+}
+
+// GetField returns raw JSON of a field by ID (e.g. "summary", "customfield_10016").
+// Fields is decoded on every call, so it always reflects the current value.
+func (i *Issue) GetField(id string) (json.RawMessage, bool) {
+	if len(i.Fields) == 0 {
+		return nil, false
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(i.Fields, &fields); err != nil {
+		return nil, false
+	}
+	value, ok := fields[id]
+	if !ok || len(value) == 0 || string(value) == "null" {
+		return nil, false
+	}
+	return value, true
+}
+
+// GetStringField returns a string field value. It reports false when the
+// field is missing, null, or not a JSON string.
+func (i *Issue) GetStringField(id string) (string, bool) {
+	value, ok := i.GetField(id)
+	if !ok {
+		return "", false
+	}
+	var result string
+	if err := json.Unmarshal(value, &result); err != nil {
+		return "", false
+	}
+	return result, true
+}
+
+// Status returns fields.status.name, e.g. "In Progress".
+func (i *Issue) Status() (string, bool) {
+	return i.getObjectString("status", "name")
+}
+
+// Priority returns fields.priority.name, e.g. "High".
+func (i *Issue) Priority() (string, bool) {
+	return i.getObjectString("priority", "name")
+}
+
+// AssigneeAccountID returns fields.assignee.accountId. It reports false for
+// unassigned issues.
+func (i *Issue) AssigneeAccountID() (string, bool) {
+	return i.getObjectString("assignee", "accountId")
+}
+
+// getObjectString returns a non-empty string property of an object field.
+func (i *Issue) getObjectString(id, property string) (string, bool) {
+	value, ok := i.GetField(id)
+	if !ok {
+		return "", false
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(value, &object); err != nil {
+		return "", false
+	}
+	var result string
+	if err := json.Unmarshal(object[property], &result); err != nil || result == "" {
+		return "", false
+	}
+	return result, true
+}
+
 // SearchResult is Jira search response (POST /rest/api/3/search/jql).
 type SearchResult struct {
 	Issues        []Issue `json:"issues"`
@@ -19,10 +98,31 @@ type SearchResult struct {
 	IsLast        bool    `json:"isLast,omitempty"`
 }
 
+// IssueFetchError reports issues that GetIssuesBulk could not return: keys or
+// IDs Jira left out of the response because they do not exist or are not
+// visible to the caller, and issues listed in issueErrors (retriable errors
+// or payload constraints).
+type IssueFetchError struct {
+	// Missing maps the requested issue ID or key to Jira's error message, or
+	// to a generic not-found message for issues that were left out.
+	Missing map[string]string
+}
+
+func (e *IssueFetchError) Error() string {
+	keys := make([]string, 0, len(e.Missing))
+	for key := range e.Missing {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return fmt.Sprintf("atlassian: %d issue(s) not fetched: %s", len(keys), strings.Join(keys, ", "))
+}
+
 // Comment is a minimal Jira comment DTO.
 type Comment struct {
 	ID   string          `json:"id"`
 	Body json.RawMessage `json:"body,omitempty"`
+	// Public is set for comments returned by the servicedeskapi.
+	Public *bool `json:"public,omitempty"`
 }
 
 // Attachment describes uploaded Jira attachment.
@@ -65,6 +165,134 @@ type BulkUsersResult struct {
 	Values     []User `json:"values,omitempty"`
 }
 
+// ChangelogOptions controls GET /rest/api/3/issue/{issueIdOrKey}/changelog query parameters.
+type ChangelogOptions struct {
+	StartAt    int
+	MaxResults int
+	FetchAll   bool
+}
+
+// ChangelogItem is a single field change within a changelog entry.
+type ChangelogItem struct {
+	Field      string `json:"field"`
+	FieldType  string `json:"fieldtype,omitempty"`
+	FieldID    string `json:"fieldId,omitempty"`
+	From       string `json:"from,omitempty"`
+	FromString string `json:"fromString,omitempty"`
+	To         string `json:"to,omitempty"`
+	ToString   string `json:"toString,omitempty"`
+}
+
+// ChangelogEntry groups field changes made by one author at one time.
+type ChangelogEntry struct {
+	ID      string          `json:"id"`
+	Author  User            `json:"author"`
+	Created string          `json:"created"`
+	Items   []ChangelogItem `json:"items"`
+}
+
+// ChangelogResult is a paginated response from GET /rest/api/3/issue/{issueIdOrKey}/changelog.
+type ChangelogResult struct {
+	Self       string           `json:"self,omitempty"`
+	NextPage   string           `json:"nextPage,omitempty"`
+	MaxResults int              `json:"maxResults,omitempty"`
+	StartAt    int              `json:"startAt,omitempty"`
+	Total      int              `json:"total,omitempty"`
+	IsLast     bool             `json:"isLast,omitempty"`
+	Values     []ChangelogEntry `json:"values,omitempty"`
+}
+
+// Project is a minimal Jira project DTO.
+type Project struct {
+	ID             string `json:"id"`
+	Key            string `json:"key"`
+	Name           string `json:"name"`
+	ProjectTypeKey string `json:"projectTypeKey,omitempty"`
+	Simplified     bool   `json:"simplified,omitempty"`
+	Style          string `json:"style,omitempty"`
+	Lead           *User  `json:"lead,omitempty"`
+	Self           string `json:"self,omitempty"`
+}
+
+// ListProjectsOptions controls GET /rest/api/3/project/search query parameters.
+type ListProjectsOptions struct {
+	StartAt    int
+	MaxResults int
+	// Query filters by project key or name (case insensitive).
+	Query    string
+	OrderBy  string
+	FetchAll bool
+}
+
+// ProjectsResult is a paginated response from GET /rest/api/3/project/search.
+type ProjectsResult struct {
+	Self       string    `json:"self,omitempty"`
+	NextPage   string    `json:"nextPage,omitempty"`
+	MaxResults int       `json:"maxResults,omitempty"`
+	StartAt    int       `json:"startAt,omitempty"`
+	Total      int       `json:"total,omitempty"`
+	IsLast     bool      `json:"isLast,omitempty"`
+	Values     []Project `json:"values,omitempty"`
+}
+
+// LabelSuggestOptions controls SuggestLabels.
+type LabelSuggestOptions struct {
+	// MaxResults caps returned suggestions. If <=0, all suggestions are returned.
+	MaxResults int
+}
+
+// Sprint is a minimal Jira Software sprint DTO.
+type Sprint struct {
+	ID            int    `json:"id"`
+	Self          string `json:"self,omitempty"`
+	State         string `json:"state"`
+	Name          string `json:"name"`
+	StartDate     string `json:"startDate,omitempty"`
+	EndDate       string `json:"endDate,omitempty"`
+	CompleteDate  string `json:"completeDate,omitempty"`
+	OriginBoardID int    `json:"originBoardId,omitempty"`
+	Goal          string `json:"goal,omitempty"`
+}
+
+// SprintOptions controls GET /rest/agile/1.0/board/{boardId}/sprint query parameters.
+type SprintOptions struct {
+	StartAt    int
+	MaxResults int
+	// State filters sprints by comma-separated states: "future", "active", "closed".
+	State    string
+	FetchAll bool
+}
+
+// SprintsResult is a paginated response from GET /rest/agile/1.0/board/{boardId}/sprint.
+type SprintsResult struct {
+	MaxResults int      `json:"maxResults,omitempty"`
+	StartAt    int      `json:"startAt,omitempty"`
+	Total      int      `json:"total,omitempty"`
+	IsLast     bool     `json:"isLast"`
+	Values     []Sprint `json:"values,omitempty"`
+}
+
+// Component is a Jira project component DTO.
+type Component struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Project     string `json:"project,omitempty"`
+	Lead        *User  `json:"lead,omitempty"`
+}
+
+// Version is a Jira project version (fix version) DTO.
+type Version struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Archived    bool   `json:"archived"`
+	Released    bool   `json:"released"`
+	StartDate   string `json:"startDate,omitempty"`
+	ReleaseDate string `json:"releaseDate,omitempty"`
+	ProjectID   int    `json:"projectId,omitempty"`
+}
+
 // CreateIssueRequest is the payload for POST /rest/api/3/issue.
 type CreateIssueRequest struct {
 	Fields          map[string]any   `json:"fields,omitempty"`
@@ -80,6 +308,30 @@ type CreatedIssue struct {
 	Self string `json:"self,omitempty"`
 }
 
+// IssueTemplate describes an issue for CreateIssueFromTemplate.
+type IssueTemplate struct {
+	ProjectKey string
+	// IssueType is the issue type name, e.g. "Task" or "Incident".
+	IssueType string
+	Summary   string
+	// Fields maps field names (e.g. "Story Points") or field IDs to values
+	// in the shape the create API expects.
+	Fields map[string]any
+	// Labels are added after creation.
+	Labels []string
+	// Watchers are account IDs added as watchers after creation.
+	Watchers []string
+}
+
+// Field describes a system or custom Jira field from GET /rest/api/3/field.
+type Field struct {
+	ID     string      `json:"id"`
+	Key    string      `json:"key,omitempty"`
+	Name   string      `json:"name"`
+	Custom bool        `json:"custom"`
+	Schema FieldSchema `json:"schema,omitempty"`
+}
+
 // UpdateIssueRequest is the payload for PUT /rest/api/3/issue/{issueIdOrKey}.
 type UpdateIssueRequest struct {
 	Fields          map[string]any   `json:"fields,omitempty"`
@@ -97,6 +349,55 @@ type UpdateIssueOptions struct {
 	Expand                 string
 }
 
+// BulkUpdateOptions controls BulkUpdateIssues behavior.
+type BulkUpdateOptions struct {
+	// Concurrency limits parallel PUT requests. Defaults to 5.
+	Concurrency int
+	NotifyUsers *bool
+}
+
+// BulkUpdateResult reports per-issue outcome of BulkUpdateIssues.
+type BulkUpdateResult struct {
+	// Succeeded lists updated keys in input order.
+	Succeeded []string
+	// Failed maps issue key to its update error.
+	Failed map[string]error
+}
+
+// FieldSchema describes the data type of a Jira field.
+type FieldSchema struct {
+	Type     string `json:"type,omitempty"`
+	Items    string `json:"items,omitempty"`
+	System   string `json:"system,omitempty"`
+	Custom   string `json:"custom,omitempty"`
+	CustomID int    `json:"customId,omitempty"`
+}
+
+// EditMetaField describes an editable issue field.
+type EditMetaField struct {
+	Name          string            `json:"name"`
+	Key           string            `json:"key,omitempty"`
+	Required      bool              `json:"required"`
+	Schema        FieldSchema       `json:"schema"`
+	Operations    []string          `json:"operations,omitempty"`
+	AllowedValues []json.RawMessage `json:"allowedValues,omitempty"`
+}
+
+// EditMeta is the response of GET /rest/api/3/issue/{issueIdOrKey}/editmeta.
+// Only fields editable by the caller are listed.
+type EditMeta struct {
+	Fields map[string]EditMetaField `json:"fields"`
+}
+
+// IsFieldEditable reports whether the field ID is present in edit metadata.
+func (m *EditMeta) IsFieldEditable(id string) bool {
+	if m == nil {
+		return false
+	}
+	_, ok := m.Fields[id]
+	return ok
+}
+
 // TransitionStatus describes the target status of a transition.
 type TransitionStatus struct {
 	ID   string `json:"id,omitempty"`
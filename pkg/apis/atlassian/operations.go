@@ -3,12 +3,17 @@ package atlassian
 import (
 	"context"
 	"errors"
+	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+const defaultAlertsPageSize = 20
+
 // OperationsService provides Jira Operations API methods.
 type OperationsService struct {
 	client *Client
@@ -37,6 +42,38 @@ func (s *OperationsService) CreateAlert(ctx context.Context, payload map[string]
 	return &resp, nil
 }
 
+// CreateAlertTyped creates a new operations alert from a typed payload.
+// Alert creation is asynchronous, so the response carries the request ID
+// rather than the alert itself.
+func (s *OperationsService) CreateAlertTyped(ctx context.Context, payload *CreateAlertRequest) (*CreateAlertResponse, error) {
+	if payload == nil || strings.TrimSpace(payload.Message) == "" {
+		return nil, errors.New("atlassian: alert message is required")
+	}
+	if payload.Priority != "" {
+		switch payload.Priority {
+		case "P1", "P2", "P3", "P4", "P5":
+		default:
+			return nil, fmt.Errorf("atlassian: invalid alert priority %q (want P1-P5)", payload.Priority)
+		}
+	}
+
+	path, err := s.client.opsPath("/alerts")
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.newCloudRequest(ctx, http.MethodPost, path, nil, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp CreateAlertResponse
+	if err := s.client.transport.DoJSON(req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // GetAlert returns alert by ID.
 func (s *OperationsService) GetAlert(ctx context.Context, alertID string) (*Alert, error) {
 	if strings.TrimSpace(alertID) == "" {
@@ -60,16 +97,82 @@ func (s *OperationsService) GetAlert(ctx context.Context, alertID string) (*Aler
 	return &alert, nil
 }
 
-// ListAlerts lists alerts with optional filters.
+// ListAlerts lists alerts with optional filters. With FetchAll it follows
+// offset pagination and returns all matching alerts in one result.
 func (s *OperationsService) ListAlerts(ctx context.Context, opts *ListAlertsOptions) (*AlertsListResult, error) {
-	path, err := s.client.opsPath("/alerts")
+	if opts == nil {
+		opts = &ListAlertsOptions{}
+	}
+	if !opts.FetchAll {
+		return s.listAlertsPage(ctx, opts, opts.Offset)
+	}
+
+	var all AlertsListResult
+	err := s.walkAlerts(ctx, opts, func(page *AlertsListResult) bool {
+		all.Values = append(all.Values, page.Values...)
+		all.Count = page.Count
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
+	return &all, nil
+}
 
+// IterateAlerts returns an iterator over all alerts matching opts, fetching
+// pages lazily as the loop advances. FetchAll is implied. Iteration stops
+// after the first error, which is yielded with a zero Alert.
+func (s *OperationsService) IterateAlerts(ctx context.Context, opts *ListAlertsOptions) iter.Seq2[Alert, error] {
 	if opts == nil {
 		opts = &ListAlertsOptions{}
 	}
+	return func(yield func(Alert, error) bool) {
+		err := s.walkAlerts(ctx, opts, func(page *AlertsListResult) bool {
+			for _, alert := range page.Values {
+				if !yield(alert, nil) {
+					return false
+				}
+			}
+			return true
+		})
+		if err != nil {
+			yield(Alert{}, err)
+		}
+	}
+}
+
+// walkAlerts requests alert pages from opts.Offset on, passing each to fn,
+// until fn returns false, a page is short or count alerts have been read.
+func (s *OperationsService) walkAlerts(ctx context.Context, opts *ListAlertsOptions, fn func(*AlertsListResult) bool) error {
+	size := opts.Size
+	if size <= 0 {
+		size = defaultAlertsPageSize
+	}
+	pageOpts := *opts
+	pageOpts.Size = size
+
+	offset := opts.Offset
+	for {
+		page, err := s.listAlertsPage(ctx, &pageOpts, offset)
+		if err != nil {
+			return err
+		}
+		if !fn(page) {
+			return nil
+		}
+
+		offset += len(page.Values)
+		if len(page.Values) < size || (page.Count > 0 && int64(offset) >= page.Count) {
+			return nil
+		}
+	}
+}
+
+func (s *OperationsService) listAlertsPage(ctx context.Context, opts *ListAlertsOptions, offset int) (*AlertsListResult, error) {
+	path, err := s.client.opsPath("/alerts")
+	if err != nil {
+		return nil, err
+	}
 
 	query := url.Values{}
 	if strings.TrimSpace(opts.Query) != "" {
@@ -78,8 +181,8 @@ func (s *OperationsService) ListAlerts(ctx context.Context, opts *ListAlertsOpti
 	if opts.Size > 0 {
 		query.Set("size", strconv.Itoa(opts.Size))
 	}
-	if opts.Offset > 0 {
-		query.Set("offset", strconv.Itoa(opts.Offset))
+	if offset > 0 {
+		query.Set("offset", strconv.Itoa(offset))
 	}
 	if strings.TrimSpace(opts.Order) != "" {
 		query.Set("order", opts.Order)
@@ -100,6 +203,113 @@ func (s *OperationsService) ListAlerts(ctx context.Context, opts *ListAlertsOpti
 	return &result, nil
 }
 
+// CreateIncident creates a new operations incident.
+func (s *OperationsService) CreateIncident(ctx context.Context, payload *CreateIncidentRequest) (*Incident, error) {
+	if payload == nil || strings.TrimSpace(payload.Message) == "" {
+		return nil, errors.New("atlassian: incident message is required")
+	}
+
+	path, err := s.client.opsPath("/incidents")
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.newCloudRequest(ctx, http.MethodPost, path, nil, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var incident Incident
+	if err := s.client.transport.DoJSON(req, &incident); err != nil {
+		return nil, err
+	}
+	return &incident, nil
+}
+
+// LinkAlertToIncident associates an existing alert with an incident.
+func (s *OperationsService) LinkAlertToIncident(ctx context.Context, incidentID, alertID string) error {
+	if strings.TrimSpace(incidentID) == "" {
+		return errors.New("atlassian: incident ID is required")
+	}
+	if strings.TrimSpace(alertID) == "" {
+		return errors.New("atlassian: alert ID is required")
+	}
+
+	path, err := s.client.opsPath("/incidents/" + url.PathEscape(incidentID) + "/alerts")
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]any{"alertIds": []string{alertID}}
+	req, err := s.client.newCloudRequest(ctx, http.MethodPost, path, nil, payload)
+	if err != nil {
+		return err
+	}
+	return s.client.doNoResponseBody(req)
+}
+
+// CreateMaintenance creates a maintenance window muting the given rules.
+func (s *OperationsService) CreateMaintenance(ctx context.Context, payload *CreateMaintenanceRequest) (*Maintenance, error) {
+	if payload == nil {
+		return nil, errors.New("atlassian: maintenance payload is required")
+	}
+	if payload.StartDate.IsZero() || payload.EndDate.IsZero() {
+		return nil, errors.New("atlassian: maintenance start and end dates are required")
+	}
+	if !payload.EndDate.After(payload.StartDate) {
+		return nil, fmt.Errorf("atlassian: maintenance end %s must be after start %s", payload.EndDate.Format(time.RFC3339), payload.StartDate.Format(time.RFC3339))
+	}
+	if len(payload.Rules) == 0 {
+		return nil, errors.New("atlassian: at least one maintenance rule is required")
+	}
+
+	path, err := s.client.opsPath("/maintenances")
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.newCloudRequest(ctx, http.MethodPost, path, nil, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var maintenance Maintenance
+	if err := s.client.transport.DoJSON(req, &maintenance); err != nil {
+		return nil, err
+	}
+	return &maintenance, nil
+}
+
+// ListMaintenance lists maintenance windows.
+func (s *OperationsService) ListMaintenance(ctx context.Context, opts *ListMaintenanceOptions) ([]Maintenance, error) {
+	path, err := s.client.opsPath("/maintenances")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts == nil {
+		opts = &ListMaintenanceOptions{}
+	}
+
+	query := url.Values{}
+	if strings.TrimSpace(opts.Type) != "" {
+		query.Set("type", opts.Type)
+	}
+
+	req, err := s.client.newCloudRequest(ctx, http.MethodGet, path, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Values []Maintenance `json:"values"`
+	}
+	if err := s.client.transport.DoJSON(req, &result); err != nil {
+		return nil, err
+	}
+	return result.Values, nil
+}
+
 // EnableOpsForTeam enables Ops capabilities for a team.
 func (s *OperationsService) EnableOpsForTeam(ctx context.Context, teamID string) error {
 	if strings.TrimSpace(teamID) == "" {
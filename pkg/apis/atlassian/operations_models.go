@@ -1,5 +1,7 @@
 package atlassian
 
+import "time"
+
 // CreateAlertResponse is the response from creating an alert.
 type CreateAlertResponse struct {
 	Result    string  `json:"result,omitempty"`
@@ -7,6 +9,19 @@ type CreateAlertResponse struct {
 	Took      float64 `json:"took,omitempty"`
 }
 
+// CreateAlertRequest is a typed payload for CreateAlertTyped.
+type CreateAlertRequest struct {
+	Message     string `json:"message"`
+	Alias       string `json:"alias,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Priority is one of P1..P5; empty uses the service default (P3).
+	Priority   string            `json:"priority,omitempty"`
+	Responders []Responder       `json:"responders,omitempty"`
+	Tags       []string          `json:"tags,omitempty"`
+	Details    map[string]string `json:"details,omitempty"`
+	Source     string            `json:"source,omitempty"`
+}
+
 // Alert is a Jira Operations alert DTO.
 type Alert struct {
 	ID              string         `json:"id,omitempty"`
@@ -41,6 +56,29 @@ type Responder struct {
 	Type string `json:"type,omitempty"`
 }
 
+// CreateIncidentRequest is the payload for creating an operations incident.
+type CreateIncidentRequest struct {
+	Message     string      `json:"message"`
+	Description string      `json:"description,omitempty"`
+	Priority    string      `json:"priority,omitempty"`
+	Responders  []Responder `json:"responders,omitempty"`
+	ServiceID   string      `json:"serviceId,omitempty"`
+}
+
+// Incident is a Jira Operations incident DTO.
+type Incident struct {
+	ID          string      `json:"id,omitempty"`
+	TinyID      string      `json:"tinyId,omitempty"`
+	Message     string      `json:"message,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Status      string      `json:"status,omitempty"`
+	Priority    string      `json:"priority,omitempty"`
+	ServiceID   string      `json:"serviceId,omitempty"`
+	Responders  []Responder `json:"responders,omitempty"`
+	CreatedAt   string      `json:"createdAt,omitempty"`
+	UpdatedAt   string      `json:"updatedAt,omitempty"`
+}
+
 // AlertsListResult represents a paginated alerts response.
 type AlertsListResult struct {
 	Values []Alert `json:"values,omitempty"`
@@ -49,11 +87,14 @@ type AlertsListResult struct {
 
 // ListAlertsOptions controls alert listing.
 type ListAlertsOptions struct {
-	Query  string
+	Query string
+	// Size is the page size; FetchAll and IterateAlerts default it to 20.
 	Size   int
 	Offset int
 	Order  string
 	Sort   string
+	// FetchAll follows offset pagination until a short page or Count is reached.
+	FetchAll bool
 }
 
 // Team is a Jira Operations team DTO.
@@ -145,3 +186,40 @@ type ListNotificationRulesOptions struct {
 	Size   int
 	Offset int
 }
+
+// MaintenanceRule mutes or disables an entity during a maintenance window.
+type MaintenanceRule struct {
+	// State is "disabled" or "noAlert".
+	State  string            `json:"state"`
+	Entity MaintenanceEntity `json:"entity"`
+}
+
+// MaintenanceEntity identifies an integration or policy covered by maintenance.
+type MaintenanceEntity struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// CreateMaintenanceRequest is the payload for creating a maintenance window.
+type CreateMaintenanceRequest struct {
+	Description string            `json:"description,omitempty"`
+	StartDate   time.Time         `json:"startDate"`
+	EndDate     time.Time         `json:"endDate"`
+	Rules       []MaintenanceRule `json:"rules"`
+}
+
+// Maintenance is a Jira Operations maintenance window DTO.
+type Maintenance struct {
+	ID          string            `json:"id,omitempty"`
+	Status      string            `json:"status,omitempty"`
+	Description string            `json:"description,omitempty"`
+	StartDate   string            `json:"startDate,omitempty"`
+	EndDate     string            `json:"endDate,omitempty"`
+	Rules       []MaintenanceRule `json:"rules,omitempty"`
+}
+
+// ListMaintenanceOptions controls maintenance list request.
+type ListMaintenanceOptions struct {
+	// Type is "all", "past" or "non-expired"; empty uses the server default.
+	Type string
+}
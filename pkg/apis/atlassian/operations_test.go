@@ -2,10 +2,12 @@ package atlassian
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
 )
@@ -44,6 +46,153 @@ func TestOperationsCreateAlert(t *testing.T) {
 	}
 }
 
+func TestOperationsCreateAlertTyped(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/jsm/ops/api/cloud-1/v1/alerts" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if body["message"] != "Disk full" || body["alias"] != "disk-db1" || body["priority"] != "P2" || body["source"] != "monitor" {
+			t.Fatalf("unexpected alert body: %v", body)
+		}
+		tags, ok := body["tags"].([]any)
+		if !ok || len(tags) != 1 || tags[0] != "db" {
+			t.Fatalf("unexpected tags: %v", body["tags"])
+		}
+		details, ok := body["details"].(map[string]any)
+		if !ok || details["host"] != "db1" {
+			t.Fatalf("unexpected details: %v", body["details"])
+		}
+		responders, ok := body["responders"].([]any)
+		if !ok || len(responders) != 1 || responders[0].(map[string]any)["type"] != "team" {
+			t.Fatalf("unexpected responders: %v", body["responders"])
+		}
+		if _, ok := body["description"]; ok {
+			t.Fatalf("expected empty description to be omitted: %v", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"result":"Request will be processed","requestId":"req-2"}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithCloudBaseURL(srv.URL),
+		WithOpsCloudID("cloud-1"),
+		WithTransport(transport.New()),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	resp, err := client.Operations().CreateAlertTyped(context.Background(), &CreateAlertRequest{
+		Message:    "Disk full",
+		Alias:      "disk-db1",
+		Priority:   "P2",
+		Responders: []Responder{{ID: "team-1", Type: "team"}},
+		Tags:       []string{"db"},
+		Details:    map[string]string{"host": "db1"},
+		Source:     "monitor",
+	})
+	if err != nil {
+		t.Fatalf("CreateAlertTyped failed: %v", err)
+	}
+	if resp.RequestID != "req-2" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+
+	if _, err := client.Operations().CreateAlertTyped(context.Background(), &CreateAlertRequest{Message: "x", Priority: "P6"}); err == nil {
+		t.Fatalf("expected error for invalid priority")
+	}
+	if _, err := client.Operations().CreateAlertTyped(context.Background(), &CreateAlertRequest{Priority: "P1"}); err == nil {
+		t.Fatalf("expected error for empty message")
+	}
+}
+
+func TestOperationsCreateIncidentAndLinkAlert(t *testing.T) {
+	t.Parallel()
+
+	linked := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		switch r.URL.Path {
+		case "/jsm/ops/api/cloud-1/v1/incidents":
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if body["message"] != "API down" || body["priority"] != "P1" || body["serviceId"] != "svc-1" {
+				t.Fatalf("unexpected incident body: %v", body)
+			}
+			responders, ok := body["responders"].([]any)
+			if !ok || len(responders) != 1 {
+				t.Fatalf("unexpected responders: %v", body["responders"])
+			}
+			if _, ok := body["description"]; ok {
+				t.Fatalf("expected empty description to be omitted: %v", body)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"inc-1","message":"API down","status":"open","priority":"P1"}`))
+		case "/jsm/ops/api/cloud-1/v1/incidents/inc-1/alerts":
+			var body struct {
+				AlertIDs []string `json:"alertIds"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if len(body.AlertIDs) != 1 || body.AlertIDs[0] != "al-9" {
+				t.Fatalf("unexpected link body: %+v", body)
+			}
+			linked = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithCloudBaseURL(srv.URL),
+		WithOpsCloudID("cloud-1"),
+		WithTransport(transport.New()),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	incident, err := client.Operations().CreateIncident(context.Background(), &CreateIncidentRequest{
+		Message:    "API down",
+		Priority:   "P1",
+		ServiceID:  "svc-1",
+		Responders: []Responder{{ID: "team-1", Type: "team"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateIncident failed: %v", err)
+	}
+	if incident.ID != "inc-1" || incident.Status != "open" {
+		t.Fatalf("unexpected incident: %+v", incident)
+	}
+
+	if err := client.Operations().LinkAlertToIncident(context.Background(), incident.ID, "al-9"); err != nil {
+		t.Fatalf("LinkAlertToIncident failed: %v", err)
+	}
+	if !linked {
+		t.Fatalf("expected link call")
+	}
+
+	if _, err := client.Operations().CreateIncident(context.Background(), &CreateIncidentRequest{Message: " "}); err == nil {
+		t.Fatalf("expected error for empty message")
+	}
+}
+
 func TestOperationsListAlertsQuery(t *testing.T) {
 	t.Parallel()
 
@@ -100,6 +249,69 @@ func TestOperationsListAlertsQuery(t *testing.T) {
 	}
 }
 
+func TestOperationsListAlertsFetchAll(t *testing.T) {
+	t.Parallel()
+
+	var offsets []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/jsm/ops/api/cloud-1/v1/alerts" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("size") != "2" || q.Get("query") != "status:open" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		offsets = append(offsets, q.Get("offset"))
+		w.Header().Set("Content-Type", "application/json")
+		switch q.Get("offset") {
+		case "":
+			_, _ = w.Write([]byte(`{"count":3,"values":[{"id":"al-1"},{"id":"al-2"}]}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"count":3,"values":[{"id":"al-3"}]}`))
+		default:
+			t.Fatalf("unexpected offset: %q", q.Get("offset"))
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithCloudBaseURL(srv.URL),
+		WithOpsCloudID("cloud-1"),
+		WithTransport(transport.New()),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	opts := &ListAlertsOptions{Query: "status:open", Size: 2, FetchAll: true}
+	result, err := client.Operations().ListAlerts(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("ListAlerts failed: %v", err)
+	}
+	if len(result.Values) != 3 || result.Values[2].ID != "al-3" || result.Count != 3 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if strings.Join(offsets, ",") != ",2" {
+		t.Fatalf("unexpected offsets: %q", offsets)
+	}
+
+	offsets = nil
+	var ids []string
+	for alert, err := range client.Operations().IterateAlerts(context.Background(), opts) {
+		if err != nil {
+			t.Fatalf("IterateAlerts failed: %v", err)
+		}
+		ids = append(ids, alert.ID)
+		if len(ids) == 2 {
+			break
+		}
+	}
+	if strings.Join(ids, ",") != "al-1,al-2" || len(offsets) != 1 {
+		t.Fatalf("expected lazy iteration to stop after first page, got ids=%v offsets=%q", ids, offsets)
+	}
+}
+
 func TestOperationsEnableTeamAndSchedules(t *testing.T) {
 	t.Parallel()
 
@@ -246,3 +458,79 @@ func TestOperationsRequireCloudID(t *testing.T) {
 		t.Fatalf("expected missing cloud id error, got: %v", err)
 	}
 }
+
+func TestOperationsMaintenance(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/jsm/ops/api/cloud-1/v1/maintenances" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if body["startDate"] != "2026-10-20T22:00:00Z" || body["endDate"] != "2026-10-21T02:00:00Z" || body["description"] != "DB upgrade" {
+				t.Fatalf("unexpected maintenance body: %v", body)
+			}
+			rules, ok := body["rules"].([]any)
+			if !ok || len(rules) != 1 {
+				t.Fatalf("unexpected rules: %v", body["rules"])
+			}
+			_, _ = w.Write([]byte(`{"id":"mnt-1","status":"planned","description":"DB upgrade","startDate":"2026-10-20T22:00:00Z","endDate":"2026-10-21T02:00:00Z"}`))
+		case http.MethodGet:
+			if r.URL.Query().Get("type") != "non-expired" {
+				t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"values":[{"id":"mnt-1","status":"active"},{"id":"mnt-2","status":"planned"}]}`))
+		default:
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithCloudBaseURL(srv.URL),
+		WithOpsCloudID("cloud-1"),
+		WithTransport(transport.New()),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	start := time.Date(2026, time.October, 20, 22, 0, 0, 0, time.UTC)
+	rules := []MaintenanceRule{{State: "disabled", Entity: MaintenanceEntity{ID: "int-1", Type: "integration"}}}
+	maintenance, err := client.Operations().CreateMaintenance(context.Background(), &CreateMaintenanceRequest{
+		Description: "DB upgrade",
+		StartDate:   start,
+		EndDate:     start.Add(4 * time.Hour),
+		Rules:       rules,
+	})
+	if err != nil {
+		t.Fatalf("CreateMaintenance failed: %v", err)
+	}
+	if maintenance.ID != "mnt-1" || maintenance.Status != "planned" || maintenance.EndDate != "2026-10-21T02:00:00Z" {
+		t.Fatalf("unexpected maintenance: %+v", maintenance)
+	}
+
+	list, err := client.Operations().ListMaintenance(context.Background(), &ListMaintenanceOptions{Type: "non-expired"})
+	if err != nil {
+		t.Fatalf("ListMaintenance failed: %v", err)
+	}
+	if len(list) != 2 || list[0].Status != "active" || list[1].ID != "mnt-2" {
+		t.Fatalf("unexpected maintenance list: %+v", list)
+	}
+
+	_, err = client.Operations().CreateMaintenance(context.Background(), &CreateMaintenanceRequest{
+		StartDate: start,
+		EndDate:   start,
+		Rules:     rules,
+	})
+	if err == nil || !strings.Contains(err.Error(), "must be after start") {
+		t.Fatalf("expected time window error, got %v", err)
+	}
+}
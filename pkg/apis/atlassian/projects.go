@@ -0,0 +1,118 @@
+package atlassian
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ProjectsService provides Jira project metadata lookups.
+type ProjectsService struct {
+	client *Client
+}
+
+// ListProjects searches Jira projects with optional pagination.
+func (s *ProjectsService) ListProjects(ctx context.Context, opts ListProjectsOptions) (*ProjectsResult, error) {
+	startAt := opts.StartAt
+	combined := make([]Project, 0)
+
+	for {
+		params := url.Values{}
+		if startAt > 0 {
+			params.Set("startAt", strconv.Itoa(startAt))
+		}
+		if opts.MaxResults > 0 {
+			params.Set("maxResults", strconv.Itoa(opts.MaxResults))
+		}
+		if strings.TrimSpace(opts.Query) != "" {
+			params.Set("query", opts.Query)
+		}
+		if strings.TrimSpace(opts.OrderBy) != "" {
+			params.Set("orderBy", opts.OrderBy)
+		}
+
+		req, err := s.client.newRequest(ctx, http.MethodGet, "/rest/api/3/project/search", params, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page ProjectsResult
+		if err := s.client.transport.DoJSON(req, &page); err != nil {
+			return nil, err
+		}
+		if !opts.FetchAll {
+			return &page, nil
+		}
+
+		combined = append(combined, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			page.Values = combined
+			page.StartAt = opts.StartAt
+			page.NextPage = ""
+			page.IsLast = true
+			return &page, nil
+		}
+		startAt = page.StartAt + len(page.Values)
+	}
+}
+
+// GetProject returns Jira project by key or ID.
+func (s *ProjectsService) GetProject(ctx context.Context, key string) (*Project, error) {
+	if strings.TrimSpace(key) == "" {
+		return nil, errors.New("atlassian: project key is required")
+	}
+
+	path := fmt.Sprintf("/rest/api/3/project/%s", url.PathEscape(key))
+	req, err := s.client.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var project Project
+	if err := s.client.transport.DoJSON(req, &project); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// ListComponents returns all components of a Jira project.
+func (s *ProjectsService) ListComponents(ctx context.Context, projectKey string) ([]Component, error) {
+	if strings.TrimSpace(projectKey) == "" {
+		return nil, errors.New("atlassian: project key is required")
+	}
+
+	path := fmt.Sprintf("/rest/api/3/project/%s/components", url.PathEscape(projectKey))
+	req, err := s.client.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var components []Component
+	if err := s.client.transport.DoJSON(req, &components); err != nil {
+		return nil, err
+	}
+	return components, nil
+}
+
+// ListVersions returns all versions of a Jira project.
+func (s *ProjectsService) ListVersions(ctx context.Context, projectKey string) ([]Version, error) {
+	if strings.TrimSpace(projectKey) == "" {
+		return nil, errors.New("atlassian: project key is required")
+	}
+
+	path := fmt.Sprintf("/rest/api/3/project/%s/versions", url.PathEscape(projectKey))
+	req, err := s.client.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []Version
+	if err := s.client.transport.DoJSON(req, &versions); err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
@@ -0,0 +1,90 @@
+package atlassian
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
+)
+
+func TestListProjectsFetchAll(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/rest/api/3/project/search" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("query") != "ops" || q.Get("maxResults") != "1" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch q.Get("startAt") {
+		case "":
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":1,"total":2,"isLast":false,"nextPage":"next","values":[{"id":"10000","key":"OPS","name":"Operations"}]}`))
+		case "1":
+			_, _ = w.Write([]byte(`{"startAt":1,"maxResults":1,"total":2,"isLast":true,"values":[{"id":"10001","key":"OPSX","name":"Ops Experiments","lead":{"accountId":"acc-1"}}]}`))
+		default:
+			t.Fatalf("unexpected startAt: %q", q.Get("startAt"))
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	result, err := client.Projects().ListProjects(context.Background(), ListProjectsOptions{
+		MaxResults: 1,
+		Query:      "ops",
+		FetchAll:   true,
+	})
+	if err != nil {
+		t.Fatalf("ListProjects failed: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+	if len(result.Values) != 2 || result.Values[0].Key != "OPS" || result.Values[1].Key != "OPSX" {
+		t.Fatalf("unexpected projects: %+v", result.Values)
+	}
+	if !result.IsLast || result.Values[1].Lead == nil || result.Values[1].Lead.AccountID != "acc-1" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestListComponents(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/project/OPS/components" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":"1","name":"Backend","project":"OPS"},{"id":"2","name":"Frontend","description":"UI"}]`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	components, err := client.Projects().ListComponents(context.Background(), "OPS")
+	if err != nil {
+		t.Fatalf("ListComponents failed: %v", err)
+	}
+	if len(components) != 2 || components[0].Name != "Backend" || components[1].Description != "UI" {
+		t.Fatalf("unexpected components: %+v", components)
+	}
+
+	if _, err := client.Projects().ListComponents(context.Background(), " "); err == nil {
+		t.Fatalf("expected error for empty project key")
+	}
+}
@@ -1,26 +1,44 @@
 package gitlab
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
 	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
 )
 
+// DefaultBaseURL is the GitLab.com REST API v4 base URL.
+const DefaultBaseURL = "https://gitlab.com/api/v4"
+
 // Option configures GitLab client.
 type Option func(*config)
 
 type config struct {
+	baseURL   string
 	token     string
 	transport *transport.Client
 }
 
 // Client is a minimal GitLab API client.
 type Client struct {
+	baseURL   *url.URL
 	token     string
 	transport *transport.Client
 }
 
 // NewClient creates GitLab API client.
 func NewClient(opts ...Option) *Client {
-	cfg := config{}
+	cfg := config{
+		baseURL: DefaultBaseURL,
+	}
 	for _, opt := range opts {
 		if opt != nil {
 			opt(&cfg)
@@ -29,12 +47,24 @@ func NewClient(opts ...Option) *Client {
 	if cfg.transport == nil {
 		cfg.transport = transport.New()
 	}
+	parsedBaseURL, err := url.Parse(strings.TrimSpace(cfg.baseURL))
+	if err != nil || parsedBaseURL.Scheme == "" || parsedBaseURL.Host == "" {
+		parsedBaseURL = nil
+	}
 	return &Client{
+		baseURL:   parsedBaseURL,
 		token:     cfg.token,
 		transport: cfg.transport,
 	}
 }
 
+// WithBaseURL overrides GitLab API base URL (e.g. https://gitlab.example.com/api/v4).
+func WithBaseURL(baseURL string) Option {
+	return func(cfg *config) {
+		cfg.baseURL = baseURL
+	}
+}
+
 // WithToken sets PRIVATE-TOKEN value.
 func WithToken(token string) Option {
 	return func(cfg *config) {
@@ -50,3 +80,95 @@ func WithTransport(tr *transport.Client) Option {
 		}
 	}
 }
+
+// newRequest creates an HTTP request resolved against the GitLab API base URL.
+// The path must already be escaped (see projectPath). Non-nil body is sent as JSON.
+func (c *Client) newRequest(ctx context.Context, method, path string, query url.Values, body any) (*http.Request, error) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("gitlab: marshal request body: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	if c.baseURL == nil {
+		return nil, errors.New("gitlab: base URL is invalid")
+	}
+
+	rel := path
+	if !strings.HasPrefix(rel, "/") {
+		rel = "/" + rel
+	}
+	endpoint := strings.TrimRight(c.baseURL.String(), "/") + rel
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab: create request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	c.setAuth(req)
+	return req, nil
+}
+
+// doJSON executes request, decodes a successful JSON body into out and
+// returns response headers (used for pagination).
+func (c *Client) doJSON(req *http.Request, out any) (http.Header, error) {
+	if req.Header.Get("Accept") == "" {
+		req.Header.Set("Accept", "application/json")
+	}
+
+	resp, err := c.transport.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, transport.NewAPIError(resp, 0)
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return resp.Header, nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("gitlab: decode response: %w", err)
+	}
+	return resp.Header, nil
+}
+
+func (c *Client) setAuth(req *http.Request) {
+	if strings.TrimSpace(c.token) != "" {
+		req.Header.Set("PRIVATE-TOKEN", c.token)
+	}
+}
+
+// projectPath builds escaped /projects/{id} path. projectID may be a numeric
+// ID or a "group/project" path, which is URL-encoded as GitLab expects.
+func projectPath(projectID any) (string, error) {
+	var id string
+	switch v := projectID.(type) {
+	case int:
+		if v > 0 {
+			id = strconv.Itoa(v)
+		}
+	case int64:
+		if v > 0 {
+			id = strconv.FormatInt(v, 10)
+		}
+	case string:
+		id = strings.TrimSpace(v)
+	default:
+		return "", fmt.Errorf("gitlab: unsupported project ID type %T", projectID)
+	}
+	if id == "" {
+		return "", errors.New("gitlab: project ID is required")
+	}
+	return "/projects/" + url.PathEscape(id), nil
+}
@@ -2,15 +2,101 @@ package gitlab
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
+	"net/url"
 	"strings"
-
-	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
+	"unicode/utf8"
 )
 
+// CreateFileOptions is the payload for POST /projects/{id}/repository/files/{path}.
+type CreateFileOptions struct {
+	Branch        string
+	CommitMessage string
+	// Content is sent as text when it is valid UTF-8, otherwise base64-encoded.
+	Content []byte
+	// StartBranch creates Branch from this branch when Branch does not exist.
+	StartBranch string
+	AuthorEmail string
+	AuthorName  string
+}
+
+// UpdateFileOptions is the payload for PUT /projects/{id}/repository/files/{path}.
+type UpdateFileOptions struct {
+	CreateFileOptions
+	// LastCommitID makes the update fail if the file changed since this commit.
+	LastCommitID string
+}
+
+// FileCommit is returned by file create/update endpoints.
+type FileCommit struct {
+	FilePath string `json:"file_path"`
+	Branch   string `json:"branch"`
+}
+
+// CreateFile commits a new file to the repository.
+func (c *Client) CreateFile(ctx context.Context, projectID any, filePath string, opts CreateFileOptions) (*FileCommit, error) {
+	return c.writeFile(ctx, http.MethodPost, projectID, filePath, opts, "")
+}
+
+// UpdateFile commits new content of an existing file.
+func (c *Client) UpdateFile(ctx context.Context, projectID any, filePath string, opts UpdateFileOptions) (*FileCommit, error) {
+	return c.writeFile(ctx, http.MethodPut, projectID, filePath, opts.CreateFileOptions, opts.LastCommitID)
+}
+
+func (c *Client) writeFile(ctx context.Context, method string, projectID any, filePath string, opts CreateFileOptions, lastCommitID string) (*FileCommit, error) {
+	filePath = strings.Trim(strings.TrimSpace(filePath), "/")
+	if filePath == "" {
+		return nil, errors.New("gitlab: file path is required")
+	}
+	if strings.TrimSpace(opts.Branch) == "" {
+		return nil, errors.New("gitlab: branch is required")
+	}
+	if strings.TrimSpace(opts.CommitMessage) == "" {
+		return nil, errors.New("gitlab: commit message is required")
+	}
+	path, err := projectPath(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]any{
+		"branch":         opts.Branch,
+		"commit_message": opts.CommitMessage,
+	}
+	if utf8.Valid(opts.Content) {
+		payload["content"] = string(opts.Content)
+	} else {
+		payload["content"] = base64.StdEncoding.EncodeToString(opts.Content)
+		payload["encoding"] = "base64"
+	}
+	if opts.StartBranch != "" {
+		payload["start_branch"] = opts.StartBranch
+	}
+	if opts.AuthorEmail != "" {
+		payload["author_email"] = opts.AuthorEmail
+	}
+	if opts.AuthorName != "" {
+		payload["author_name"] = opts.AuthorName
+	}
+	if lastCommitID != "" {
+		payload["last_commit_id"] = lastCommitID
+	}
+
+	req, err := c.newRequest(ctx, method, path+"/repository/files/"+url.PathEscape(filePath), nil, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var commit FileCommit
+	if _, err := c.doJSON(req, &commit); err != nil {
+		return nil, err
+	}
+	return &commit, nil
+}
+
 // DownloadRawFileByURL downloads raw file bytes from GitLab by absolute URL.
 func (c *Client) DownloadRawFileByURL(ctx context.Context, rawURL string) ([]byte, error) {
 	if strings.TrimSpace(rawURL) == "" {
@@ -25,19 +111,9 @@ func (c *Client) DownloadRawFileByURL(ctx context.Context, rawURL string) ([]byt
 		req.Header.Set("PRIVATE-TOKEN", c.token)
 	}
 
-	resp, err := c.transport.Do(req)
+	data, _, err := c.transport.DoBytes(req)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
-		return nil, transport.NewAPIError(resp, 0)
-	}
-
-	data, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("gitlab: read response body: %w", err)
-	}
 	return data, nil
 }
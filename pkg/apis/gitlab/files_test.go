@@ -2,6 +2,8 @@ package gitlab
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -82,3 +84,98 @@ func TestDownloadRawFileByURLReturnsAPIError(t *testing.T) {
 		t.Fatalf("unexpected status code: %d", apiErr.StatusCode)
 	}
 }
+
+func TestCreateFile(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		if r.URL.EscapedPath() != "/projects/ops%2Fconfig/repository/files/deploy%2Fvalues.yaml" {
+			t.Fatalf("unexpected path: %s", r.URL.EscapedPath())
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if body["branch"] != "main" || body["commit_message"] != "Bump replicas" || body["content"] != "replicas: 3\n" {
+			t.Fatalf("unexpected body: %v", body)
+		}
+		if _, ok := body["encoding"]; ok {
+			t.Fatalf("text content must not be base64 encoded: %v", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"file_path":"deploy/values.yaml","branch":"main"}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	commit, err := client.CreateFile(context.Background(), "ops/config", "deploy/values.yaml", CreateFileOptions{
+		Branch:        "main",
+		CommitMessage: "Bump replicas",
+		Content:       []byte("replicas: 3\n"),
+	})
+	if err != nil {
+		t.Fatalf("CreateFile failed: %v", err)
+	}
+	if commit.FilePath != "deploy/values.yaml" || commit.Branch != "main" {
+		t.Fatalf("unexpected commit: %+v", commit)
+	}
+}
+
+func TestUpdateFileBinaryContent(t *testing.T) {
+	t.Parallel()
+
+	content := []byte{0x89, 'P', 'N', 'G', 0xff, 0x00}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		if r.URL.EscapedPath() != "/projects/5/repository/files/assets%2Flogo.png" {
+			t.Fatalf("unexpected path: %s", r.URL.EscapedPath())
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if body["encoding"] != "base64" || body["content"] != base64.StdEncoding.EncodeToString(content) {
+			t.Fatalf("unexpected encoded content: %v", body)
+		}
+		if body["last_commit_id"] != "abc123" {
+			t.Fatalf("unexpected last_commit_id: %v", body["last_commit_id"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"file_path":"assets/logo.png","branch":"main"}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	_, err := client.UpdateFile(context.Background(), 5, "assets/logo.png", UpdateFileOptions{
+		CreateFileOptions: CreateFileOptions{
+			Branch:        "main",
+			CommitMessage: "Update logo",
+			Content:       content,
+		},
+		LastCommitID: "abc123",
+	})
+	if err != nil {
+		t.Fatalf("UpdateFile failed: %v", err)
+	}
+}
+
+func TestCreateFileValidation(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient(WithTransport(transport.New()))
+	if _, err := client.CreateFile(context.Background(), 1, "a.txt", CreateFileOptions{CommitMessage: "x"}); err == nil {
+		t.Fatalf("expected error for missing branch")
+	}
+	if _, err := client.CreateFile(context.Background(), 1, "a.txt", CreateFileOptions{Branch: "main"}); err == nil {
+		t.Fatalf("expected error for missing commit message")
+	}
+	if _, err := client.CreateFile(context.Background(), 1, " ", CreateFileOptions{Branch: "main", CommitMessage: "x"}); err == nil {
+		t.Fatalf("expected error for missing file path")
+	}
+}
@@ -0,0 +1,114 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GitLabIssue is a minimal GitLab issue DTO.
+type GitLabIssue struct {
+	ID          int      `json:"id"`
+	IID         int      `json:"iid"`
+	ProjectID   int      `json:"project_id,omitempty"`
+	Title       string   `json:"title"`
+	Description string   `json:"description,omitempty"`
+	State       string   `json:"state,omitempty"`
+	WebURL      string   `json:"web_url,omitempty"`
+	Labels      []string `json:"labels,omitempty"`
+	CreatedAt   string   `json:"created_at,omitempty"`
+	UpdatedAt   string   `json:"updated_at,omitempty"`
+}
+
+// CreateIssueOptions is the payload for POST /projects/{id}/issues.
+type CreateIssueOptions struct {
+	Title       string
+	Description string
+	Labels      []string
+	AssigneeIDs []int
+}
+
+// ListIssuesOptions controls GET /projects/{id}/issues query parameters.
+type ListIssuesOptions struct {
+	ListOptions
+	// State filters by "opened" or "closed". Empty returns all issues.
+	State  string
+	Labels []string
+	Search string
+	// Keyset switches to keyset pagination (pagination=keyset ordered by id
+	// ascending) and follows the rel="next" URL of the Link header instead of
+	// page numbers. Page is ignored. Use it for large issue lists where offset
+	// pagination slows down.
+	Keyset bool
+}
+
+// CreateIssue creates an issue in the project.
+func (c *Client) CreateIssue(ctx context.Context, projectID any, opts CreateIssueOptions) (*GitLabIssue, error) {
+	if strings.TrimSpace(opts.Title) == "" {
+		return nil, errors.New("gitlab: issue title is required")
+	}
+	path, err := projectPath(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]any{
+		"title": opts.Title,
+	}
+	if opts.Description != "" {
+		payload["description"] = opts.Description
+	}
+	if labels := joinNonEmpty(opts.Labels); labels != "" {
+		payload["labels"] = labels
+	}
+	if len(opts.AssigneeIDs) > 0 {
+		payload["assignee_ids"] = opts.AssigneeIDs
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, path+"/issues", nil, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var issue GitLabIssue
+	if _, err := c.doJSON(req, &issue); err != nil {
+		return nil, err
+	}
+	return &issue, nil
+}
+
+// ListIssues lists project issues and optionally follows pagination.
+func (c *Client) ListIssues(ctx context.Context, projectID any, opts ListIssuesOptions) ([]GitLabIssue, error) {
+	path, err := projectPath(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	if strings.TrimSpace(opts.State) != "" {
+		query.Set("state", opts.State)
+	}
+	if labels := joinNonEmpty(opts.Labels); labels != "" {
+		query.Set("labels", labels)
+	}
+	if strings.TrimSpace(opts.Search) != "" {
+		query.Set("search", opts.Search)
+	}
+
+	if opts.Keyset {
+		return listKeyset[GitLabIssue](ctx, c, path+"/issues", query, opts.ListOptions)
+	}
+	return listPages[GitLabIssue](ctx, c, path+"/issues", query, opts.ListOptions)
+}
+
+func joinNonEmpty(values []string) string {
+	trimmed := make([]string, 0, len(values))
+	for _, value := range values {
+		if v := strings.TrimSpace(value); v != "" {
+			trimmed = append(trimmed, v)
+		}
+	}
+	return strings.Join(trimmed, ",")
+}
@@ -0,0 +1,197 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
+)
+
+func TestCreateIssue(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		if r.URL.EscapedPath() != "/projects/ops%2Fincidents/issues" {
+			t.Fatalf("unexpected path: %s", r.URL.EscapedPath())
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/json" {
+			t.Fatalf("unexpected content type: %q", got)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if body["title"] != "DB outage" {
+			t.Fatalf("unexpected title: %v", body["title"])
+		}
+		if body["description"] != "Primary is down" {
+			t.Fatalf("unexpected description: %v", body["description"])
+		}
+		if body["labels"] != "incident,sev1" {
+			t.Fatalf("unexpected labels: %v", body["labels"])
+		}
+		assignees, ok := body["assignee_ids"].([]any)
+		if !ok || len(assignees) != 2 || assignees[0] != float64(10) || assignees[1] != float64(11) {
+			t.Fatalf("unexpected assignee_ids: %v", body["assignee_ids"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":100,"iid":5,"title":"DB outage","state":"opened","web_url":"https://gitlab.example/ops/incidents/-/issues/5","labels":["incident","sev1"]}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL), WithToken("token-123"), WithTransport(transport.New()))
+	issue, err := client.CreateIssue(context.Background(), "ops/incidents", CreateIssueOptions{
+		Title:       "DB outage",
+		Description: "Primary is down",
+		Labels:      []string{"incident", " ", "sev1"},
+		AssigneeIDs: []int{10, 11},
+	})
+	if err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	if issue.IID != 5 || issue.State != "opened" || len(issue.Labels) != 2 {
+		t.Fatalf("unexpected issue: %+v", issue)
+	}
+}
+
+func TestCreateIssueValidation(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient(WithTransport(transport.New()))
+	if _, err := client.CreateIssue(context.Background(), 1, CreateIssueOptions{Title: "  "}); err == nil {
+		t.Fatalf("expected error for empty title")
+	}
+	if _, err := client.CreateIssue(context.Background(), "", CreateIssueOptions{Title: "x"}); err == nil {
+		t.Fatalf("expected error for empty project ID")
+	}
+}
+
+func TestListIssuesFetchAll(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/projects/7/issues" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("state") != "opened" || q.Get("labels") != "incident" || q.Get("per_page") != "2" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch q.Get("page") {
+		case "1":
+			w.Header().Set("X-Next-Page", "2")
+			_, _ = w.Write([]byte(`[{"iid":1,"title":"a","state":"opened","web_url":"u1","labels":["incident"]},{"iid":2,"title":"b","state":"opened"}]`))
+		case "2":
+			w.Header().Set("X-Next-Page", "")
+			_, _ = w.Write([]byte(`[{"iid":3,"title":"c","state":"opened"}]`))
+		default:
+			t.Fatalf("unexpected page: %q", q.Get("page"))
+		}
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	issues, err := client.ListIssues(context.Background(), 7, ListIssuesOptions{
+		ListOptions: ListOptions{PerPage: 2, FetchAll: true},
+		State:       "opened",
+		Labels:      []string{"incident"},
+	})
+	if err != nil {
+		t.Fatalf("ListIssues failed: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+	if len(issues) != 3 || issues[0].IID != 1 || issues[2].Title != "c" {
+		t.Fatalf("unexpected issues: %+v", issues)
+	}
+	if issues[0].WebURL != "u1" || len(issues[0].Labels) != 1 {
+		t.Fatalf("unexpected decoded issue: %+v", issues[0])
+	}
+}
+
+func TestListIssuesKeysetFollowsLinkHeader(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/projects/7/issues" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("pagination") != "keyset" || q.Get("order_by") != "id" || q.Get("sort") != "asc" || q.Get("per_page") != "2" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		if q.Get("page") != "" {
+			t.Fatalf("keyset request must not send page: %s", r.URL.RawQuery)
+		}
+		if r.Header.Get("PRIVATE-TOKEN") != "glpat" {
+			t.Fatalf("expected token on every page, got %q", r.Header.Get("PRIVATE-TOKEN"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch q.Get("id_after") {
+		case "":
+			w.Header().Set("Link", `<`+srv.URL+`/projects/7/issues?id_after=2&order_by=id&pagination=keyset&per_page=2&sort=asc&state=opened>; rel="next", <`+srv.URL+`/projects/7/issues?order_by=id&pagination=keyset&per_page=2&sort=asc>; rel="first"`)
+			_, _ = w.Write([]byte(`[{"iid":1,"title":"a","state":"opened"},{"iid":2,"title":"b","state":"opened"}]`))
+		case "2":
+			w.Header().Set("Link", `<`+srv.URL+`/projects/7/issues?order_by=id&pagination=keyset&per_page=2&sort=asc>; rel="first"`)
+			_, _ = w.Write([]byte(`[{"iid":3,"title":"c","state":"opened"}]`))
+		default:
+			t.Fatalf("unexpected id_after: %q", q.Get("id_after"))
+		}
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL), WithToken("glpat"), WithTransport(transport.New()))
+	issues, err := client.ListIssues(context.Background(), 7, ListIssuesOptions{
+		ListOptions: ListOptions{PerPage: 2, FetchAll: true},
+		State:       "opened",
+		Keyset:      true,
+	})
+	if err != nil {
+		t.Fatalf("ListIssues failed: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+	if len(issues) != 3 || issues[0].IID != 1 || issues[2].IID != 3 {
+		t.Fatalf("unexpected issues: %+v", issues)
+	}
+}
+
+func TestListIssuesKeysetRejectsForeignLinkHost(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Link", `<https://evil.example/projects/7/issues?id_after=1>; rel="next"`)
+		_, _ = w.Write([]byte(`[{"iid":1,"title":"a","state":"opened"}]`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL), WithToken("glpat"), WithTransport(transport.New()))
+	_, err := client.ListIssues(context.Background(), 7, ListIssuesOptions{
+		ListOptions: ListOptions{FetchAll: true},
+		Keyset:      true,
+	})
+	if err == nil || !strings.Contains(err.Error(), "does not match base URL host") {
+		t.Fatalf("expected foreign host error, got %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
+)
+
+// DownloadJobArtifacts downloads the job artifacts archive (zip) into memory.
+func (c *Client) DownloadJobArtifacts(ctx context.Context, projectID any, jobID int) ([]byte, error) {
+	body, err := c.DownloadJobArtifactsStream(ctx, projectID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	return readAllAndClose(body)
+}
+
+// DownloadJobArtifactsStream streams the job artifacts archive (zip).
+// Caller must close the returned reader.
+func (c *Client) DownloadJobArtifactsStream(ctx context.Context, projectID any, jobID int) (io.ReadCloser, error) {
+	path, err := jobPath(projectID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	return c.openStream(ctx, path+"/artifacts")
+}
+
+// DownloadArtifactFile downloads a single file from the job artifacts archive.
+func (c *Client) DownloadArtifactFile(ctx context.Context, projectID any, jobID int, artifactPath string) ([]byte, error) {
+	body, err := c.DownloadArtifactFileStream(ctx, projectID, jobID, artifactPath)
+	if err != nil {
+		return nil, err
+	}
+	return readAllAndClose(body)
+}
+
+// DownloadArtifactFileStream streams a single file from the job artifacts archive.
+// Caller must close the returned reader.
+func (c *Client) DownloadArtifactFileStream(ctx context.Context, projectID any, jobID int, artifactPath string) (io.ReadCloser, error) {
+	artifactPath = strings.Trim(strings.TrimSpace(artifactPath), "/")
+	if artifactPath == "" {
+		return nil, errors.New("gitlab: artifact path is required")
+	}
+	path, err := jobPath(projectID, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := strings.Split(artifactPath, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return c.openStream(ctx, path+"/artifacts/"+strings.Join(segments, "/"))
+}
+
+func jobPath(projectID any, jobID int) (string, error) {
+	if jobID <= 0 {
+		return "", errors.New("gitlab: job ID is required")
+	}
+	path, err := projectPath(projectID)
+	if err != nil {
+		return "", err
+	}
+	return path + "/jobs/" + strconv.Itoa(jobID), nil
+}
+
+func (c *Client) openStream(ctx context.Context, path string) (io.ReadCloser, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.transport.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		defer resp.Body.Close()
+		return nil, transport.NewAPIError(resp, 0)
+	}
+	return resp.Body, nil
+}
+
+func readAllAndClose(body io.ReadCloser) ([]byte, error) {
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab: read response body: %w", err)
+	}
+	return data, nil
+}
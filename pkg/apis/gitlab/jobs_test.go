@@ -0,0 +1,115 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
+)
+
+func TestDownloadJobArtifacts(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		if r.URL.EscapedPath() != "/api/v4/projects/group%2Fproject/jobs/42/artifacts" {
+			t.Fatalf("unexpected path: %s", r.URL.EscapedPath())
+		}
+		if got := r.Header.Get("PRIVATE-TOKEN"); got != "token-123" {
+			t.Fatalf("unexpected PRIVATE-TOKEN: %q", got)
+		}
+		w.Header().Set("Content-Type", "application/zip")
+		_, _ = w.Write([]byte("PK\x03\x04zip"))
+	}))
+	defer srv.Close()
+
+	client := NewClient(
+		WithBaseURL(srv.URL+"/api/v4"),
+		WithToken("token-123"),
+		WithTransport(transport.New()),
+	)
+
+	data, err := client.DownloadJobArtifacts(context.Background(), "group/project", 42)
+	if err != nil {
+		t.Fatalf("DownloadJobArtifacts failed: %v", err)
+	}
+	if string(data) != "PK\x03\x04zip" {
+		t.Fatalf("unexpected body: %q", string(data))
+	}
+}
+
+func TestDownloadArtifactFileStream(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/projects/7/jobs/42/artifacts/reports/junit%20report.xml" {
+			t.Fatalf("unexpected path: %s", r.URL.EscapedPath())
+		}
+		_, _ = w.Write([]byte("<testsuite/>"))
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+
+	body, err := client.DownloadArtifactFileStream(context.Background(), 7, 42, "/reports/junit report.xml")
+	if err != nil {
+		t.Fatalf("DownloadArtifactFileStream failed: %v", err)
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatalf("read stream: %v", err)
+	}
+	if string(data) != "<testsuite/>" {
+		t.Fatalf("unexpected body: %q", string(data))
+	}
+}
+
+func TestDownloadArtifactFileReturnsAPIError(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"404 Not found"}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	_, err := client.DownloadArtifactFile(context.Background(), 7, 42, "missing.txt")
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+
+	var apiErr *transport.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected transport.APIError, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("unexpected status code: %d", apiErr.StatusCode)
+	}
+}
+
+func TestDownloadJobArtifactsValidation(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient(WithTransport(transport.New()))
+	if _, err := client.DownloadJobArtifacts(context.Background(), "", 1); err == nil {
+		t.Fatalf("expected error for empty project ID")
+	}
+	if _, err := client.DownloadJobArtifacts(context.Background(), 1, 0); err == nil {
+		t.Fatalf("expected error for empty job ID")
+	}
+	if _, err := client.DownloadArtifactFile(context.Background(), 1, 1, " "); err == nil {
+		t.Fatalf("expected error for empty artifact path")
+	}
+	if _, err := client.DownloadJobArtifacts(context.Background(), 1.5, 1); err == nil {
+		t.Fatalf("expected error for unsupported project ID type")
+	}
+}
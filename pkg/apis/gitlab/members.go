@@ -0,0 +1,80 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// GitLab access levels.
+const (
+	AccessLevelNone       = 0
+	AccessLevelMinimal    = 5
+	AccessLevelGuest      = 10
+	AccessLevelPlanner    = 15
+	AccessLevelReporter   = 20
+	AccessLevelDeveloper  = 30
+	AccessLevelMaintainer = 40
+	AccessLevelOwner      = 50
+)
+
+// Member is a GitLab project member DTO.
+type Member struct {
+	ID          int    `json:"id"`
+	Username    string `json:"username"`
+	Name        string `json:"name"`
+	State       string `json:"state,omitempty"`
+	AccessLevel int    `json:"access_level"`
+	// ExpiresAt is a YYYY-MM-DD date, empty when membership does not expire.
+	ExpiresAt string `json:"expires_at,omitempty"`
+	WebURL    string `json:"web_url,omitempty"`
+}
+
+// ListProjectMembersOptions controls GET /projects/{id}/members/all query parameters.
+type ListProjectMembersOptions struct {
+	ListOptions
+	// Query filters members by name or username.
+	Query string
+}
+
+// AccessLevelName returns the GitLab role name for an access level,
+// e.g. "Developer" for 30.
+func AccessLevelName(level int) string {
+	switch level {
+	case AccessLevelNone:
+		return "No access"
+	case AccessLevelMinimal:
+		return "Minimal access"
+	case AccessLevelGuest:
+		return "Guest"
+	case AccessLevelPlanner:
+		return "Planner"
+	case AccessLevelReporter:
+		return "Reporter"
+	case AccessLevelDeveloper:
+		return "Developer"
+	case AccessLevelMaintainer:
+		return "Maintainer"
+	case AccessLevelOwner:
+		return "Owner"
+	default:
+		return fmt.Sprintf("Unknown (%d)", level)
+	}
+}
+
+// ListProjectMembers lists project members including ones inherited from
+// parent groups and optionally follows pagination.
+func (c *Client) ListProjectMembers(ctx context.Context, projectID any, opts ListProjectMembersOptions) ([]Member, error) {
+	path, err := projectPath(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	if strings.TrimSpace(opts.Query) != "" {
+		query.Set("query", opts.Query)
+	}
+
+	return listPages[Member](ctx, c, path+"/members/all", query, opts.ListOptions)
+}
@@ -0,0 +1,69 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
+)
+
+func TestListProjectMembers(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.EscapedPath() != "/projects/group%2Fapp/members/all" {
+			t.Fatalf("unexpected path: %s", r.URL.EscapedPath())
+		}
+		if r.URL.Query().Get("query") != "al" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "1" {
+			w.Header().Set("X-Next-Page", "2")
+			_, _ = w.Write([]byte(`[{"id":1,"username":"alice","name":"Alice","state":"active","access_level":50}]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"id":2,"username":"alan","name":"Alan","access_level":30,"expires_at":"2026-12-31"}]`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	members, err := client.ListProjectMembers(context.Background(), "group/app", ListProjectMembersOptions{
+		ListOptions: ListOptions{FetchAll: true},
+		Query:       "al",
+	})
+	if err != nil {
+		t.Fatalf("ListProjectMembers failed: %v", err)
+	}
+	if requests != 2 || len(members) != 2 {
+		t.Fatalf("expected 2 members over 2 requests, got %d members in %d requests", len(members), requests)
+	}
+	if members[0].Username != "alice" || AccessLevelName(members[0].AccessLevel) != "Owner" {
+		t.Fatalf("unexpected first member: %+v", members[0])
+	}
+	if members[1].ExpiresAt != "2026-12-31" || AccessLevelName(members[1].AccessLevel) != "Developer" {
+		t.Fatalf("unexpected second member: %+v", members[1])
+	}
+}
+
+func TestAccessLevelName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[int]string{
+		AccessLevelGuest:      "Guest",
+		AccessLevelReporter:   "Reporter",
+		AccessLevelMaintainer: "Maintainer",
+		AccessLevelMinimal:    "Minimal access",
+		42:                    "Unknown (42)",
+	}
+	for level, want := range tests {
+		if got := AccessLevelName(level); got != want {
+			t.Fatalf("AccessLevelName(%d) = %q, want %q", level, got, want)
+		}
+	}
+}
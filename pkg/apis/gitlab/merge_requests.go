@@ -0,0 +1,111 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
+)
+
+// ErrMergeNotAllowed is returned when GitLab responds 405 to a merge request
+// action, e.g. because the MR is a draft, has unresolved discussions or is
+// already merged. It wraps the underlying *transport.APIError.
+var ErrMergeNotAllowed = errors.New("gitlab: merge request action not allowed")
+
+// MergeRequest is a minimal GitLab merge request DTO.
+type MergeRequest struct {
+	ID             int    `json:"id"`
+	IID            int    `json:"iid"`
+	ProjectID      int    `json:"project_id,omitempty"`
+	Title          string `json:"title"`
+	State          string `json:"state,omitempty"`
+	SourceBranch   string `json:"source_branch,omitempty"`
+	TargetBranch   string `json:"target_branch,omitempty"`
+	MergeStatus    string `json:"merge_status,omitempty"`
+	SHA            string `json:"sha,omitempty"`
+	MergeCommitSHA string `json:"merge_commit_sha,omitempty"`
+	WebURL         string `json:"web_url,omitempty"`
+	CreatedAt      string `json:"created_at,omitempty"`
+	UpdatedAt      string `json:"updated_at,omitempty"`
+	MergedAt       string `json:"merged_at,omitempty"`
+}
+
+// AcceptMROptions is the payload for PUT /projects/{id}/merge_requests/{iid}/merge.
+type AcceptMROptions struct {
+	MergeWhenPipelineSucceeds bool
+	Squash                    bool
+	ShouldRemoveSourceBranch  bool
+	// SHA, when set, makes the merge fail if the source branch HEAD differs.
+	SHA string
+}
+
+// ApproveMergeRequest approves the merge request as the token user.
+func (c *Client) ApproveMergeRequest(ctx context.Context, projectID any, mrIID int) error {
+	path, err := mergeRequestPath(projectID, mrIID)
+	if err != nil {
+		return err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, path+"/approve", nil, nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doJSON(req, nil)
+	return mergeRequestError(err)
+}
+
+// AcceptMergeRequest merges the merge request, or schedules the merge when
+// opts.MergeWhenPipelineSucceeds is set.
+func (c *Client) AcceptMergeRequest(ctx context.Context, projectID any, mrIID int, opts AcceptMROptions) (*MergeRequest, error) {
+	path, err := mergeRequestPath(projectID, mrIID)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]any{}
+	if opts.MergeWhenPipelineSucceeds {
+		payload["merge_when_pipeline_succeeds"] = true
+	}
+	if opts.Squash {
+		payload["squash"] = true
+	}
+	if opts.ShouldRemoveSourceBranch {
+		payload["should_remove_source_branch"] = true
+	}
+	if opts.SHA != "" {
+		payload["sha"] = opts.SHA
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPut, path+"/merge", nil, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var mr MergeRequest
+	if _, err := c.doJSON(req, &mr); err != nil {
+		return nil, mergeRequestError(err)
+	}
+	return &mr, nil
+}
+
+func mergeRequestPath(projectID any, mrIID int) (string, error) {
+	if mrIID <= 0 {
+		return "", errors.New("gitlab: merge request IID is required")
+	}
+	path, err := projectPath(projectID)
+	if err != nil {
+		return "", err
+	}
+	return path + "/merge_requests/" + strconv.Itoa(mrIID), nil
+}
+
+func mergeRequestError(err error) error {
+	var apiErr *transport.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusMethodNotAllowed {
+		return fmt.Errorf("%w: %w", ErrMergeNotAllowed, err)
+	}
+	return err
+}
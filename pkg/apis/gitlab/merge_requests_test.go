@@ -0,0 +1,89 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
+)
+
+func TestApproveMergeRequest(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		switch r.URL.EscapedPath() {
+		case "/projects/group%2Fapp/merge_requests/12/approve":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":5,"iid":12,"approved":true}`))
+		case "/projects/group%2Fapp/merge_requests/13/approve":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			_, _ = w.Write([]byte(`{"message":"405 Method Not Allowed"}`))
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.EscapedPath())
+		}
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	if err := client.ApproveMergeRequest(context.Background(), "group/app", 12); err != nil {
+		t.Fatalf("ApproveMergeRequest failed: %v", err)
+	}
+
+	err := client.ApproveMergeRequest(context.Background(), "group/app", 13)
+	if !errors.Is(err, ErrMergeNotAllowed) {
+		t.Fatalf("expected ErrMergeNotAllowed, got %v", err)
+	}
+	var apiErr *transport.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatalf("expected wrapped 405 APIError, got %v", err)
+	}
+
+	if err := client.ApproveMergeRequest(context.Background(), "group/app", 0); err == nil {
+		t.Fatalf("expected error for missing IID")
+	}
+}
+
+func TestAcceptMergeRequestWithOptions(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/projects/7/merge_requests/3/merge" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if body["merge_when_pipeline_succeeds"] != true || body["squash"] != true || body["should_remove_source_branch"] != true {
+			t.Fatalf("unexpected body: %v", body)
+		}
+		if _, ok := body["sha"]; ok {
+			t.Fatalf("expected empty sha to be omitted: %v", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":30,"iid":3,"title":"Release 1.2","state":"merged","merge_commit_sha":"abc123","web_url":"https://gitlab.example/p/-/merge_requests/3"}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	mr, err := client.AcceptMergeRequest(context.Background(), 7, 3, AcceptMROptions{
+		MergeWhenPipelineSucceeds: true,
+		Squash:                    true,
+		ShouldRemoveSourceBranch:  true,
+	})
+	if err != nil {
+		t.Fatalf("AcceptMergeRequest failed: %v", err)
+	}
+	if mr.IID != 3 || mr.State != "merged" || mr.MergeCommitSHA != "abc123" {
+		t.Fatalf("unexpected merge request: %+v", mr)
+	}
+}
@@ -0,0 +1,177 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const defaultPerPage = 20
+
+// ListOptions controls GitLab offset pagination shared by list methods.
+type ListOptions struct {
+	// Page is the 1-based page to start from. If <=0, the first page is used.
+	Page int
+	// PerPage is page size (GitLab caps it at 100). If <=0, a default of 20 is used.
+	PerPage int
+	// FetchAll follows X-Next-Page headers until the last page.
+	FetchAll bool
+}
+
+// listPages fetches a list endpoint and follows X-Next-Page response headers
+// when opts.FetchAll is set.
+func listPages[T any](ctx context.Context, c *Client, path string, query url.Values, opts ListOptions) ([]T, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	perPage := opts.PerPage
+	if perPage <= 0 {
+		perPage = defaultPerPage
+	}
+	page := opts.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	var all []T
+	for {
+		query.Set("per_page", strconv.Itoa(perPage))
+		query.Set("page", strconv.Itoa(page))
+
+		req, err := c.newRequest(ctx, http.MethodGet, path, query, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var items []T
+		headers, err := c.doJSON(req, &items)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+
+		if !opts.FetchAll {
+			return all, nil
+		}
+		next, err := nextPage(headers)
+		if err != nil {
+			return nil, err
+		}
+		if next == 0 || len(items) == 0 {
+			return all, nil
+		}
+		if next <= page {
+			return nil, fmt.Errorf("gitlab: X-Next-Page %d does not advance past page %d", next, page)
+		}
+		page = next
+	}
+}
+
+// listKeyset fetches a list endpoint with keyset pagination and follows the
+// Link rel="next" URL when opts.FetchAll is set. Only use it for endpoints
+// that support pagination=keyset ordered by id. opts.Page is ignored.
+func listKeyset[T any](ctx context.Context, c *Client, path string, query url.Values, opts ListOptions) ([]T, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	perPage := opts.PerPage
+	if perPage <= 0 {
+		perPage = defaultPerPage
+	}
+	query.Set("pagination", "keyset")
+	query.Set("per_page", strconv.Itoa(perPage))
+	if query.Get("order_by") == "" {
+		query.Set("order_by", "id")
+	}
+	if query.Get("sort") == "" {
+		query.Set("sort", "asc")
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, path, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var all []T
+	for {
+		var items []T
+		headers, err := c.doJSON(req, &items)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+
+		if !opts.FetchAll {
+			return all, nil
+		}
+		next := nextLink(headers)
+		if next == "" || len(items) == 0 {
+			return all, nil
+		}
+		if next == req.URL.String() {
+			return nil, fmt.Errorf("gitlab: Link next URL %q does not advance", next)
+		}
+		if req, err = c.newLinkRequest(ctx, next); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// newLinkRequest creates a GET request for a pagination URL taken from a Link
+// header. The URL must point at the configured GitLab host, so the token is
+// never sent elsewhere.
+func (c *Client) newLinkRequest(ctx context.Context, rawURL string) (*http.Request, error) {
+	if c.baseURL == nil {
+		return nil, errors.New("gitlab: base URL is invalid")
+	}
+	next, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab: parse Link next URL %q: %w", rawURL, err)
+	}
+	if next.Scheme != c.baseURL.Scheme || next.Host != c.baseURL.Host {
+		return nil, fmt.Errorf("gitlab: Link next URL %q does not match base URL host", rawURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, next.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab: create request: %w", err)
+	}
+	c.setAuth(req)
+	return req, nil
+}
+
+// nextLink returns the rel="next" target of a Link header, or "" if absent.
+func nextLink(headers http.Header) string {
+	for _, header := range headers.Values("Link") {
+		for _, link := range strings.Split(header, ",") {
+			target, params, ok := strings.Cut(strings.TrimSpace(link), ";")
+			target = strings.TrimSpace(target)
+			if !ok || !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, param := range strings.Split(params, ";") {
+				key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+				if strings.EqualFold(key, "rel") && strings.Trim(value, `"`) == "next" {
+					return strings.TrimSuffix(strings.TrimPrefix(target, "<"), ">")
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func nextPage(headers http.Header) (int, error) {
+	raw := strings.TrimSpace(headers.Get("X-Next-Page"))
+	if raw == "" {
+		return 0, nil
+	}
+	next, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("gitlab: parse X-Next-Page %q: %w", raw, err)
+	}
+	return next, nil
+}
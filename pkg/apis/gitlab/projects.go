@@ -0,0 +1,35 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+)
+
+// Project is a minimal GitLab project DTO.
+type Project struct {
+	ID                int    `json:"id"`
+	Name              string `json:"name"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	DefaultBranch     string `json:"default_branch,omitempty"`
+	WebURL            string `json:"web_url,omitempty"`
+	Visibility        string `json:"visibility,omitempty"`
+}
+
+// GetProject returns a project by numeric ID or "group/project" path.
+func (c *Client) GetProject(ctx context.Context, projectID any) (*Project, error) {
+	path, err := projectPath(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var project Project
+	if _, err := c.doJSON(req, &project); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
@@ -0,0 +1,44 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
+)
+
+func TestGetProject(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		switch r.URL.EscapedPath() {
+		case "/projects/42", "/projects/platform%2Fbackend%2Fapi":
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.EscapedPath())
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":42,"name":"api","path_with_namespace":"platform/backend/api","default_branch":"main","web_url":"https://gitlab.example/platform/backend/api","visibility":"internal"}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+
+	for _, projectID := range []any{42, "platform/backend/api"} {
+		project, err := client.GetProject(context.Background(), projectID)
+		if err != nil {
+			t.Fatalf("GetProject(%v) failed: %v", projectID, err)
+		}
+		if project.ID != 42 || project.PathWithNamespace != "platform/backend/api" || project.DefaultBranch != "main" || project.Visibility != "internal" {
+			t.Fatalf("unexpected project: %+v", project)
+		}
+	}
+
+	if _, err := client.GetProject(context.Background(), 3.5); err == nil {
+		t.Fatalf("expected error for unsupported project ID type")
+	}
+}
@@ -0,0 +1,82 @@
+package gitlab
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// Commit is a minimal GitLab commit DTO.
+type Commit struct {
+	ID        string `json:"id"`
+	ShortID   string `json:"short_id,omitempty"`
+	Title     string `json:"title,omitempty"`
+	Message   string `json:"message,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	WebURL    string `json:"web_url,omitempty"`
+}
+
+// Branch is a GitLab repository branch DTO.
+type Branch struct {
+	Name      string `json:"name"`
+	Merged    bool   `json:"merged"`
+	Protected bool   `json:"protected"`
+	Default   bool   `json:"default,omitempty"`
+	WebURL    string `json:"web_url,omitempty"`
+	Commit    Commit `json:"commit"`
+}
+
+// Tag is a GitLab repository tag DTO.
+type Tag struct {
+	Name      string `json:"name"`
+	Target    string `json:"target"`
+	Message   string `json:"message,omitempty"`
+	Protected bool   `json:"protected,omitempty"`
+	Commit    Commit `json:"commit"`
+}
+
+// ListTagsOptions controls GET /projects/{id}/repository/tags query parameters.
+type ListTagsOptions struct {
+	ListOptions
+	Search string
+	// OrderBy is one of "name", "updated" or "version".
+	OrderBy string
+	// Sort is "asc" or "desc".
+	Sort string
+}
+
+// ListBranches returns all repository branches, optionally filtered by search term.
+func (c *Client) ListBranches(ctx context.Context, projectID any, search string) ([]Branch, error) {
+	path, err := projectPath(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	if strings.TrimSpace(search) != "" {
+		query.Set("search", search)
+	}
+
+	return listPages[Branch](ctx, c, path+"/repository/branches", query, ListOptions{PerPage: 100, FetchAll: true})
+}
+
+// ListTags lists repository tags and optionally follows pagination.
+func (c *Client) ListTags(ctx context.Context, projectID any, opts ListTagsOptions) ([]Tag, error) {
+	path, err := projectPath(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	if strings.TrimSpace(opts.Search) != "" {
+		query.Set("search", opts.Search)
+	}
+	if strings.TrimSpace(opts.OrderBy) != "" {
+		query.Set("order_by", opts.OrderBy)
+	}
+	if strings.TrimSpace(opts.Sort) != "" {
+		query.Set("sort", opts.Sort)
+	}
+
+	return listPages[Tag](ctx, c, path+"/repository/tags", query, opts.ListOptions)
+}
@@ -0,0 +1,90 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
+)
+
+func TestListBranches(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.EscapedPath() != "/projects/group%2Fapp/repository/branches" {
+			t.Fatalf("unexpected path: %s", r.URL.EscapedPath())
+		}
+		q := r.URL.Query()
+		if q.Get("search") != "release" {
+			t.Fatalf("unexpected search: %q", q.Get("search"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if q.Get("page") == "1" {
+			w.Header().Set("X-Next-Page", "2")
+			_, _ = w.Write([]byte(`[{"name":"release-1","merged":true,"protected":true,"commit":{"id":"abc123"}}]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"name":"release-2","merged":false,"protected":false,"commit":{"id":"def456"}}]`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	branches, err := client.ListBranches(context.Background(), "group/app", "release")
+	if err != nil {
+		t.Fatalf("ListBranches failed: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+	if len(branches) != 2 {
+		t.Fatalf("expected 2 branches, got %d", len(branches))
+	}
+	if branches[0].Name != "release-1" || !branches[0].Merged || !branches[0].Protected || branches[0].Commit.ID != "abc123" {
+		t.Fatalf("unexpected first branch: %+v", branches[0])
+	}
+	if branches[1].Commit.ID != "def456" {
+		t.Fatalf("unexpected second branch: %+v", branches[1])
+	}
+}
+
+func TestListTags(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/projects/9/repository/tags" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("order_by") != "version" || q.Get("sort") != "desc" || q.Get("search") != "^v1" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		if q.Get("page") != "1" || q.Get("per_page") != "20" {
+			t.Fatalf("unexpected pagination query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Next-Page", "2")
+		_, _ = w.Write([]byte(`[{"name":"v1.2.0","target":"f00ba7","message":"Release 1.2.0","commit":{"id":"f00ba7"}}]`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(WithBaseURL(srv.URL), WithTransport(transport.New()))
+	tags, err := client.ListTags(context.Background(), 9, ListTagsOptions{
+		Search:  "^v1",
+		OrderBy: "version",
+		Sort:    "desc",
+	})
+	if err != nil {
+		t.Fatalf("ListTags failed: %v", err)
+	}
+	if len(tags) != 1 {
+		t.Fatalf("expected single page without FetchAll, got %d tags", len(tags))
+	}
+	if tags[0].Name != "v1.2.0" || tags[0].Target != "f00ba7" || tags[0].Message != "Release 1.2.0" {
+		t.Fatalf("unexpected tag: %+v", tags[0])
+	}
+}
@@ -0,0 +1,165 @@
+package gitlab
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// GitLab webhook event names sent in the X-Gitlab-Event header.
+const (
+	EventPush         = "Push Hook"
+	EventTagPush      = "Tag Push Hook"
+	EventMergeRequest = "Merge Request Hook"
+	EventPipeline     = "Pipeline Hook"
+)
+
+// ErrInvalidWebhookToken is returned by VerifyToken when X-Gitlab-Token does not match.
+var ErrInvalidWebhookToken = errors.New("gitlab: invalid webhook token")
+
+// WebhookUser is the user who triggered a webhook event.
+type WebhookUser struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Username string `json:"username"`
+	Email    string `json:"email,omitempty"`
+}
+
+// WebhookProject is the project a webhook event belongs to.
+type WebhookProject struct {
+	ID                int    `json:"id"`
+	Name              string `json:"name"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	WebURL            string `json:"web_url"`
+	DefaultBranch     string `json:"default_branch,omitempty"`
+}
+
+// WebhookCommit is a commit included in a push event.
+type WebhookCommit struct {
+	ID        string `json:"id"`
+	Message   string `json:"message"`
+	Title     string `json:"title,omitempty"`
+	Timestamp string `json:"timestamp,omitempty"`
+	URL       string `json:"url,omitempty"`
+	Author    struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	} `json:"author"`
+}
+
+// PushEvent is the payload of Push Hook and Tag Push Hook events.
+type PushEvent struct {
+	ObjectKind   string          `json:"object_kind"`
+	Before       string          `json:"before"`
+	After        string          `json:"after"`
+	Ref          string          `json:"ref"`
+	CheckoutSHA  string          `json:"checkout_sha,omitempty"`
+	UserID       int             `json:"user_id"`
+	UserName     string          `json:"user_name"`
+	UserUsername string          `json:"user_username"`
+	ProjectID    int             `json:"project_id"`
+	Project      WebhookProject  `json:"project"`
+	Commits      []WebhookCommit `json:"commits"`
+	TotalCommits int             `json:"total_commits_count"`
+}
+
+// MergeRequestEvent is the payload of Merge Request Hook events.
+type MergeRequestEvent struct {
+	ObjectKind       string         `json:"object_kind"`
+	EventType        string         `json:"event_type,omitempty"`
+	User             WebhookUser    `json:"user"`
+	Project          WebhookProject `json:"project"`
+	ObjectAttributes struct {
+		ID           int    `json:"id"`
+		IID          int    `json:"iid"`
+		Title        string `json:"title"`
+		State        string `json:"state"`
+		Action       string `json:"action,omitempty"`
+		SourceBranch string `json:"source_branch"`
+		TargetBranch string `json:"target_branch"`
+		MergeStatus  string `json:"merge_status,omitempty"`
+		URL          string `json:"url"`
+		LastCommit   struct {
+			ID string `json:"id"`
+		} `json:"last_commit"`
+	} `json:"object_attributes"`
+	Labels []struct {
+		Title string `json:"title"`
+	} `json:"labels,omitempty"`
+}
+
+// PipelineEvent is the payload of Pipeline Hook events.
+type PipelineEvent struct {
+	ObjectKind       string         `json:"object_kind"`
+	User             WebhookUser    `json:"user"`
+	Project          WebhookProject `json:"project"`
+	ObjectAttributes struct {
+		ID         int    `json:"id"`
+		IID        int    `json:"iid,omitempty"`
+		Ref        string `json:"ref"`
+		Tag        bool   `json:"tag"`
+		SHA        string `json:"sha"`
+		Status     string `json:"status"`
+		Source     string `json:"source,omitempty"`
+		Duration   int    `json:"duration,omitempty"`
+		CreatedAt  string `json:"created_at,omitempty"`
+		FinishedAt string `json:"finished_at,omitempty"`
+	} `json:"object_attributes"`
+	Builds []struct {
+		ID     int    `json:"id"`
+		Stage  string `json:"stage"`
+		Name   string `json:"name"`
+		Status string `json:"status"`
+	} `json:"builds,omitempty"`
+}
+
+// VerifyToken checks X-Gitlab-Token against secret using constant-time compare.
+func VerifyToken(secret string, r *http.Request) error {
+	if secret == "" {
+		return errors.New("gitlab: webhook secret is required")
+	}
+	if r == nil {
+		return errors.New("gitlab: request is required")
+	}
+	token := r.Header.Get("X-Gitlab-Token")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+		return ErrInvalidWebhookToken
+	}
+	return nil
+}
+
+// ParseEvent reads the webhook body and decodes it by X-Gitlab-Event into
+// *PushEvent (push and tag push), *MergeRequestEvent or *PipelineEvent.
+// The event name is returned as the second value.
+func ParseEvent(r *http.Request) (any, string, error) {
+	if r == nil || r.Body == nil {
+		return nil, "", errors.New("gitlab: request body is required")
+	}
+	eventType := r.Header.Get("X-Gitlab-Event")
+
+	var event any
+	switch eventType {
+	case EventPush, EventTagPush:
+		event = &PushEvent{}
+	case EventMergeRequest:
+		event = &MergeRequestEvent{}
+	case EventPipeline:
+		event = &PipelineEvent{}
+	case "":
+		return nil, "", errors.New("gitlab: X-Gitlab-Event header is missing")
+	default:
+		return nil, eventType, fmt.Errorf("gitlab: unsupported webhook event %q", eventType)
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, eventType, fmt.Errorf("gitlab: read webhook body: %w", err)
+	}
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, eventType, fmt.Errorf("gitlab: decode webhook event: %w", err)
+	}
+	return event, eventType, nil
+}
@@ -0,0 +1,71 @@
+package gitlab
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestVerifyToken(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest("POST", "/hooks/gitlab", nil)
+	req.Header.Set("X-Gitlab-Token", "s3cret")
+	if err := VerifyToken("s3cret", req); err != nil {
+		t.Fatalf("expected valid token, got %v", err)
+	}
+
+	req.Header.Set("X-Gitlab-Token", "wrong")
+	if err := VerifyToken("s3cret", req); !errors.Is(err, ErrInvalidWebhookToken) {
+		t.Fatalf("expected ErrInvalidWebhookToken, got %v", err)
+	}
+
+	req.Header.Del("X-Gitlab-Token")
+	if err := VerifyToken("s3cret", req); !errors.Is(err, ErrInvalidWebhookToken) {
+		t.Fatalf("expected ErrInvalidWebhookToken for missing header, got %v", err)
+	}
+}
+
+func TestParseEventPush(t *testing.T) {
+	t.Parallel()
+
+	body := `{"object_kind":"push","before":"aaa","after":"bbb","ref":"refs/heads/main","user_username":"jdoe","project_id":15,
+		"project":{"id":15,"name":"app","path_with_namespace":"group/app","web_url":"https://gitlab.example/group/app"},
+		"commits":[{"id":"bbb","message":"Fix bug\n","author":{"name":"John","email":"j@example.com"}}],"total_commits_count":1}`
+	req := httptest.NewRequest("POST", "/hooks/gitlab", strings.NewReader(body))
+	req.Header.Set("X-Gitlab-Event", "Push Hook")
+
+	event, eventType, err := ParseEvent(req)
+	if err != nil {
+		t.Fatalf("ParseEvent failed: %v", err)
+	}
+	if eventType != EventPush {
+		t.Fatalf("unexpected event type: %q", eventType)
+	}
+	push, ok := event.(*PushEvent)
+	if !ok {
+		t.Fatalf("expected *PushEvent, got %T", event)
+	}
+	if push.Ref != "refs/heads/main" || push.After != "bbb" || push.Project.PathWithNamespace != "group/app" {
+		t.Fatalf("unexpected push event: %+v", push)
+	}
+	if len(push.Commits) != 1 || push.Commits[0].Author.Email != "j@example.com" || push.TotalCommits != 1 {
+		t.Fatalf("unexpected commits: %+v", push.Commits)
+	}
+}
+
+func TestParseEventUnsupported(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest("POST", "/hooks/gitlab", strings.NewReader(`{}`))
+	req.Header.Set("X-Gitlab-Event", "Wiki Page Hook")
+	if _, eventType, err := ParseEvent(req); err == nil || eventType != "Wiki Page Hook" {
+		t.Fatalf("expected unsupported event error, got type=%q err=%v", eventType, err)
+	}
+
+	req = httptest.NewRequest("POST", "/hooks/gitlab", strings.NewReader(`{}`))
+	if _, _, err := ParseEvent(req); err == nil {
+		t.Fatalf("expected error for missing event header")
+	}
+}
@@ -0,0 +1,85 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// AdminService provides Enterprise Grid admin.* operations. These methods
+// require an org-level user token with the matching admin.* scopes granted
+// by an org owner or admin; workspace bot tokens are rejected by Slack.
+type AdminService struct {
+	client *Client
+}
+
+// SetConversationTeams sets the workspaces a channel is shared to via
+// admin.conversations.setTeams.
+func (s *AdminService) SetConversationTeams(ctx context.Context, channelID string, teamIDs []string) error {
+	if strings.TrimSpace(channelID) == "" {
+		return errors.New("slack: channel ID is required")
+	}
+	teams := joinNonEmpty(teamIDs)
+	if teams == "" {
+		return errors.New("slack: at least one team ID is required")
+	}
+
+	form := url.Values{}
+	form.Set("channel_id", channelID)
+	form.Set("target_team_ids", teams)
+	s.client.withTeamID(form)
+
+	req, err := s.client.newFormRequest(ctx, "admin.conversations.setTeams", form)
+	if err != nil {
+		return err
+	}
+	return s.client.do(req, nil)
+}
+
+// InviteUserToTeam invites email to a workspace via admin.users.invite and
+// adds the user to channelIDs once they join. Empty teamID falls back to
+// WithTeamID. Slack does not return the invited user's ID, so the returned
+// User only carries TeamID and Profile.Email.
+func (s *AdminService) InviteUserToTeam(ctx context.Context, teamID, email string, channelIDs []string) (*User, error) {
+	if strings.TrimSpace(email) == "" {
+		return nil, errors.New("slack: email is required")
+	}
+	channels := joinNonEmpty(channelIDs)
+	if channels == "" {
+		return nil, errors.New("slack: at least one channel ID is required")
+	}
+
+	form := url.Values{}
+	if trimmed := strings.TrimSpace(teamID); trimmed != "" {
+		form.Set("team_id", trimmed)
+	}
+	s.client.withTeamID(form)
+	if form.Get("team_id") == "" {
+		return nil, errors.New("slack: team ID is required")
+	}
+	form.Set("email", email)
+	form.Set("channel_ids", channels)
+
+	req, err := s.client.newFormRequest(ctx, "admin.users.invite", form)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.do(req, nil); err != nil {
+		return nil, err
+	}
+
+	user := &User{TeamID: form.Get("team_id")}
+	user.Profile.Email = email
+	return user, nil
+}
+
+func joinNonEmpty(values []string) string {
+	out := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			out = append(out, value)
+		}
+	}
+	return strings.Join(out, ",")
+}
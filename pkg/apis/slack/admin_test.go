@@ -0,0 +1,77 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
+)
+
+func TestAdminSetConversationTeams(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin.conversations.setTeams" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		_ = r.ParseForm()
+		if r.PostForm.Get("channel_id") != "C1" || r.PostForm.Get("target_team_ids") != "T1,T2" || r.PostForm.Get("team_id") != "T-org" {
+			t.Fatalf("unexpected form: %v", r.PostForm)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxp-org"), WithTeamID("T-org"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if err := client.Admin().SetConversationTeams(context.Background(), "C1", []string{"T1", " ", "T2"}); err != nil {
+		t.Fatalf("SetConversationTeams failed: %v", err)
+	}
+	if err := client.Admin().SetConversationTeams(context.Background(), "C1", nil); err == nil {
+		t.Fatalf("expected error for empty team IDs")
+	}
+}
+
+func TestAdminInviteUserToTeam(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin.users.invite" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		_ = r.ParseForm()
+		if r.PostForm.Get("team_id") != "T2" || r.PostForm.Get("email") != "new@example.com" || r.PostForm.Get("channel_ids") != "C1,C2" {
+			t.Fatalf("unexpected form: %v", r.PostForm)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxp-org"), WithTeamID("T-org"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	user, err := client.Admin().InviteUserToTeam(context.Background(), "T2", "new@example.com", []string{"C1", "C2"})
+	if err != nil {
+		t.Fatalf("InviteUserToTeam failed: %v", err)
+	}
+	if user.TeamID != "T2" || user.Profile.Email != "new@example.com" {
+		t.Fatalf("unexpected user: %+v", user)
+	}
+
+	noTeam, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxp-org"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	if _, err := noTeam.Admin().InviteUserToTeam(context.Background(), "", "new@example.com", []string{"C1"}); err == nil {
+		t.Fatalf("expected error for missing team ID")
+	}
+}
@@ -0,0 +1,34 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+)
+
+// AuthService provides Slack token (auth.*) operations.
+type AuthService struct {
+	client *Client
+}
+
+// Revoke revokes the client token using auth.revoke and reports whether it
+// was revoked. With test set, Slack only checks the call (dry run) and the
+// token stays valid.
+func (s *AuthService) Revoke(ctx context.Context, test bool) (bool, error) {
+	form := url.Values{}
+	if test {
+		form.Set("test", "true")
+	}
+
+	req, err := s.client.newFormRequest(ctx, "auth.revoke", form)
+	if err != nil {
+		return false, err
+	}
+
+	var response struct {
+		Revoked bool `json:"revoked"`
+	}
+	if err := s.client.do(req, &response); err != nil {
+		return false, err
+	}
+	return response.Revoked, nil
+}
@@ -0,0 +1,74 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
+)
+
+func TestAuthRevoke(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/auth.revoke" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		_ = r.ParseForm()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.PostForm.Get("test") {
+		case "true":
+			_, _ = w.Write([]byte(`{"ok":true,"revoked":false}`))
+		case "":
+			_, _ = w.Write([]byte(`{"ok":true,"revoked":true}`))
+		default:
+			t.Fatalf("unexpected form: %v", r.PostForm)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	revoked, err := client.Auth().Revoke(context.Background(), true)
+	if err != nil {
+		t.Fatalf("Revoke dry run failed: %v", err)
+	}
+	if revoked {
+		t.Fatalf("expected dry run not to revoke the token")
+	}
+
+	revoked, err = client.Auth().Revoke(context.Background(), false)
+	if err != nil {
+		t.Fatalf("Revoke failed: %v", err)
+	}
+	if !revoked {
+		t.Fatalf("expected token to be revoked")
+	}
+}
+
+func TestAuthRevokeSurfacesSlackError(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":false,"error":"invalid_auth"}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	_, err = client.Auth().Revoke(context.Background(), false)
+	var slackErr *Error
+	if !errors.As(err, &slackErr) || slackErr.Code != "invalid_auth" {
+		t.Fatalf("expected invalid_auth slack error, got %v", err)
+	}
+}
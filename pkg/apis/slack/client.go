@@ -24,6 +24,9 @@ type config struct {
 	token     string
 	teamID    string
 	transport *transport.Client
+	ignored   []string
+	onWarning func([]string)
+	markdown  bool
 }
 
 // Client is Slack Web API client.
@@ -32,6 +35,9 @@ type Client struct {
 	token     string
 	teamID    string
 	transport *transport.Client
+	ignored   map[string]struct{}
+	onWarning func([]string)
+	markdown  bool
 
 	userGroups    *UserGroupsService
 	conversations *ConversationsService
@@ -39,6 +45,10 @@ type Client struct {
 	users         *UsersService
 	views         *ViewsService
 	canvas        *CanvasService
+	team          *TeamService
+	reminders     *RemindersService
+	admin         *AdminService
+	auth          *AuthService
 }
 
 // NewClient creates Slack Web API client.
@@ -68,6 +78,16 @@ func NewClient(opts ...Option) (*Client, error) {
 		token:     strings.TrimSpace(cfg.token),
 		teamID:    strings.TrimSpace(cfg.teamID),
 		transport: cfg.transport,
+		onWarning: cfg.onWarning,
+		markdown:  cfg.markdown,
+	}
+	for _, code := range cfg.ignored {
+		if code = strings.TrimSpace(code); code != "" {
+			if client.ignored == nil {
+				client.ignored = make(map[string]struct{})
+			}
+			client.ignored[code] = struct{}{}
+		}
 	}
 	client.userGroups = &UserGroupsService{client: client}
 	client.conversations = &ConversationsService{client: client}
@@ -75,6 +95,10 @@ func NewClient(opts ...Option) (*Client, error) {
 	client.users = &UsersService{client: client}
 	client.views = &ViewsService{client: client}
 	client.canvas = &CanvasService{client: client}
+	client.team = &TeamService{client: client}
+	client.reminders = &RemindersService{client: client}
+	client.admin = &AdminService{client: client}
+	client.auth = &AuthService{client: client}
 
 	return client, nil
 }
@@ -109,6 +133,33 @@ func WithTransport(tr *transport.Client) Option {
 	}
 }
 
+// WithIgnoredSlackErrors treats ok=false responses with the given error codes
+// (e.g. "already_reacted", "already_in_channel") as success. The response body
+// is still decoded into the method result where possible.
+func WithIgnoredSlackErrors(codes ...string) Option {
+	return func(cfg *config) {
+		cfg.ignored = append(cfg.ignored, codes...)
+	}
+}
+
+// WithWarningHandler registers a callback invoked with warning codes from
+// successful responses (the "warning" field and response_metadata.warnings),
+// e.g. "missing_charset" or deprecation notices.
+func WithWarningHandler(handler func(warnings []string)) Option {
+	return func(cfg *config) {
+		cfg.onWarning = handler
+	}
+}
+
+// WithMarkdownConversion makes PostMessage convert request Text from standard
+// Markdown to Slack mrkdwn with ToMrkdwn. Blocks and attachments are not
+// changed.
+func WithMarkdownConversion() Option {
+	return func(cfg *config) {
+		cfg.markdown = true
+	}
+}
+
 // UserGroups returns user groups API service.
 func (c *Client) UserGroups() *UserGroupsService {
 	return c.userGroups
@@ -139,6 +190,26 @@ func (c *Client) Canvas() *CanvasService {
 	return c.canvas
 }
 
+// Team returns workspace (team) API service.
+func (c *Client) Team() *TeamService {
+	return c.team
+}
+
+// Reminders returns reminders API service.
+func (c *Client) Reminders() *RemindersService {
+	return c.reminders
+}
+
+// Admin returns Enterprise Grid admin API service (org tokens only).
+func (c *Client) Admin() *AdminService {
+	return c.admin
+}
+
+// Auth returns token (auth.*) API service.
+func (c *Client) Auth() *AuthService {
+	return c.auth
+}
+
 func (c *Client) newFormRequest(ctx context.Context, method string, form url.Values) (*http.Request, error) {
 	if form == nil {
 		form = url.Values{}
@@ -244,7 +315,14 @@ func (c *Client) do(req *http.Request, out any) error {
 		if okRaw, hasOK := raw["ok"]; hasOK {
 			var ok bool
 			if err := json.Unmarshal(okRaw, &ok); err == nil && !ok {
-				return parseSlackError(raw)
+				if _, ignored := c.ignored[rawString(raw, "error")]; !ignored {
+					return parseSlackError(raw)
+				}
+			}
+		}
+		if c.onWarning != nil {
+			if warnings := parseSlackWarnings(raw); len(warnings) > 0 {
+				c.onWarning(warnings)
 			}
 		}
 	}
@@ -268,6 +346,35 @@ func parseSlackError(raw map[string]json.RawMessage) error {
 	return result
 }
 
+// parseSlackWarnings collects unique warning codes from the comma-separated
+// "warning" field and the response_metadata.warnings array.
+func parseSlackWarnings(raw map[string]json.RawMessage) []string {
+	codes := strings.Split(rawString(raw, "warning"), ",")
+	if metaRaw, ok := raw["response_metadata"]; ok {
+		var meta struct {
+			Warnings []string `json:"warnings"`
+		}
+		if err := json.Unmarshal(metaRaw, &meta); err == nil {
+			codes = append(codes, meta.Warnings...)
+		}
+	}
+
+	seen := make(map[string]struct{}, len(codes))
+	warnings := make([]string, 0, len(codes))
+	for _, code := range codes {
+		code = strings.TrimSpace(code)
+		if code == "" {
+			continue
+		}
+		if _, dup := seen[code]; dup {
+			continue
+		}
+		seen[code] = struct{}{}
+		warnings = append(warnings, code)
+	}
+	return warnings
+}
+
 func rawString(raw map[string]json.RawMessage, key string) string {
 	value, ok := raw[key]
 	if !ok {
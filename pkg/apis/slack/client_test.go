@@ -2,6 +2,7 @@ package slack
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -365,3 +366,120 @@ func TestSlackClientRespectsBasePath(t *testing.T) {
 		t.Fatalf("PostMessage failed: %v", err)
 	}
 }
+
+func TestWithIgnoredSlackErrors(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/reactions.add":
+			_, _ = w.Write([]byte(`{"ok":false,"error":"already_reacted"}`))
+		case "/conversations.invite":
+			_, _ = w.Write([]byte(`{"ok":false,"error":"already_in_channel","channel":{"id":"C1","name":"incident"}}`))
+		default:
+			_, _ = w.Write([]byte(`{"ok":false,"error":"channel_not_found"}`))
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithToken("xoxb-test"),
+		WithTransport(transport.New()),
+		WithIgnoredSlackErrors("already_reacted", "already_in_channel"),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	req, err := client.newJSONRequest(context.Background(), "reactions.add", map[string]string{"channel": "C1", "name": "eyes", "timestamp": "1.1"})
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	if err := client.do(req, nil); err != nil {
+		t.Fatalf("expected already_reacted to be ignored, got %v", err)
+	}
+
+	channel, err := client.Conversations().InviteUsersToChannel(context.Background(), []string{"U1"}, "C1")
+	if err != nil {
+		t.Fatalf("expected already_in_channel to be ignored, got %v", err)
+	}
+	if channel == nil || channel.ID != "C1" {
+		t.Fatalf("expected channel to be decoded, got %+v", channel)
+	}
+
+	_, err = client.Messages().PostMessage(context.Background(), &PostMessageRequest{Channel: "C1", Text: "hello"})
+	var slackErr *Error
+	if !errors.As(err, &slackErr) || slackErr.Code != "channel_not_found" {
+		t.Fatalf("expected channel_not_found error, got %v", err)
+	}
+}
+
+func TestWithWarningHandler(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"channel":"C1","ts":"1.2","warning":"missing_charset,superfluous_charset","response_metadata":{"warnings":["missing_charset","method_deprecated"]}}`))
+	}))
+	defer srv.Close()
+
+	var got [][]string
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithToken("xoxb-test"),
+		WithTransport(transport.New()),
+		WithWarningHandler(func(warnings []string) {
+			got = append(got, warnings)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	posted, err := client.Messages().PostMessage(context.Background(), &PostMessageRequest{Channel: "C1", Text: "hello"})
+	if err != nil {
+		t.Fatalf("PostMessage failed: %v", err)
+	}
+	if posted.TS != "1.2" {
+		t.Fatalf("unexpected posted message: %+v", posted)
+	}
+	if len(got) != 1 {
+		t.Fatalf("expected handler to fire once, got %d", len(got))
+	}
+	want := []string{"missing_charset", "superfluous_charset", "method_deprecated"}
+	if strings.Join(got[0], ",") != strings.Join(want, ",") {
+		t.Fatalf("unexpected warnings: %v", got[0])
+	}
+}
+
+func TestSlackErrorMissingScopes(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":false,"error":"missing_scope","needed":"chat:write,channels:read","provided":"channels:read,users:read"}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	_, err = client.Messages().PostMessage(context.Background(), &PostMessageRequest{Channel: "C123", Text: "hello"})
+	var slackErr *Error
+	if !errors.As(err, &slackErr) {
+		t.Fatalf("expected slack.Error, got %v", err)
+	}
+	if !slackErr.IsMissingScope() {
+		t.Fatalf("expected missing_scope error, got %q", slackErr.Code)
+	}
+	if got := slackErr.MissingScopes(); len(got) != 1 || got[0] != "chat:write" {
+		t.Fatalf("unexpected missing scopes: %v", got)
+	}
+	if (&Error{Code: "channel_not_found"}).IsMissingScope() {
+		t.Fatalf("expected non-scope error to report false")
+	}
+}
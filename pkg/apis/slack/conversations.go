@@ -3,11 +3,16 @@ package slack
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"unicode"
 )
 
+// maxChannelNameLength is the Slack limit for channel names.
+const maxChannelNameLength = 80
+
 // ConversationsService provides Slack conversation operations.
 type ConversationsService struct {
 	client *Client
@@ -15,6 +20,32 @@ type ConversationsService struct {
 
 // GetConversationList returns conversations and follows cursor pagination.
 func (s *ConversationsService) GetConversationList(ctx context.Context, excludeArchived bool, channelTypes []string) ([]Conversation, error) {
+	return s.ListConversations(ctx, &ListConversationsRequest{
+		ExcludeArchived: excludeArchived,
+		Types:           channelTypes,
+	})
+}
+
+// conversationTypes is the set of types accepted by conversations.list.
+var conversationTypes = map[string]struct{}{
+	"public_channel":  {},
+	"private_channel": {},
+	"mpim":            {},
+	"im":              {},
+}
+
+// ListConversations returns all conversations using cursor pagination.
+// Types are validated against public_channel, private_channel, mpim and im.
+func (s *ConversationsService) ListConversations(ctx context.Context, req *ListConversationsRequest) ([]Conversation, error) {
+	if req == nil {
+		req = &ListConversationsRequest{}
+	}
+
+	types, err := normalizeConversationTypes(req.Types)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		cursor string
 		all    []Conversation
@@ -22,18 +53,21 @@ func (s *ConversationsService) GetConversationList(ctx context.Context, excludeA
 
 	for {
 		params := url.Values{}
-		if excludeArchived {
+		if req.ExcludeArchived {
 			params.Set("exclude_archived", "true")
 		}
-		if len(channelTypes) > 0 {
-			params.Set("types", strings.Join(channelTypes, ","))
+		if len(types) > 0 {
+			params.Set("types", strings.Join(types, ","))
+		}
+		if req.Limit > 0 {
+			params.Set("limit", strconv.Itoa(req.Limit))
 		}
 		if cursor != "" {
 			params.Set("cursor", cursor)
 		}
 		s.client.withTeamID(params)
 
-		req, err := s.client.newGetRequest(ctx, "conversations.list", params)
+		httpReq, err := s.client.newGetRequest(ctx, "conversations.list", params)
 		if err != nil {
 			return nil, err
 		}
@@ -42,7 +76,7 @@ func (s *ConversationsService) GetConversationList(ctx context.Context, excludeA
 			Channels         []Conversation   `json:"channels"`
 			ResponseMetadata ResponseMetadata `json:"response_metadata"`
 		}
-		if err := s.client.do(req, &response); err != nil {
+		if err := s.client.do(httpReq, &response); err != nil {
 			return nil, err
 		}
 		all = append(all, response.Channels...)
@@ -54,15 +88,44 @@ func (s *ConversationsService) GetConversationList(ctx context.Context, excludeA
 	}
 }
 
+// normalizeConversationTypes trims types, drops empty ones and rejects
+// values Slack does not know.
+func normalizeConversationTypes(channelTypes []string) ([]string, error) {
+	types := make([]string, 0, len(channelTypes))
+	for _, channelType := range channelTypes {
+		channelType = strings.TrimSpace(channelType)
+		if channelType == "" {
+			continue
+		}
+		if _, ok := conversationTypes[channelType]; !ok {
+			return nil, fmt.Errorf("slack: unknown conversation type %q (want public_channel, private_channel, mpim or im)", channelType)
+		}
+		types = append(types, channelType)
+	}
+	return types, nil
+}
+
 // CreateConversation creates a Slack channel.
 func (s *ConversationsService) CreateConversation(ctx context.Context, name string, isPrivate bool) (*Conversation, error) {
+	return s.CreateConversationWithOptions(ctx, CreateConversationOptions{Name: name, IsPrivate: isPrivate})
+}
+
+// CreateConversationWithOptions creates a Slack channel, optionally
+// normalizing its name first, then sets topic and purpose. If the channel is
+// created but setting topic or purpose fails, the channel is returned
+// together with the joined errors.
+func (s *ConversationsService) CreateConversationWithOptions(ctx context.Context, opts CreateConversationOptions) (*Conversation, error) {
+	name := opts.Name
+	if opts.NormalizeName {
+		name = NormalizeChannelName(name)
+	}
 	if strings.TrimSpace(name) == "" {
 		return nil, errors.New("slack: conversation name is required")
 	}
 
 	form := url.Values{}
 	form.Set("name", name)
-	if isPrivate {
+	if opts.IsPrivate {
 		form.Set("is_private", "true")
 	}
 	s.client.withTeamID(form)
@@ -78,7 +141,129 @@ func (s *ConversationsService) CreateConversation(ctx context.Context, name stri
 	if err := s.client.do(req, &response); err != nil {
 		return nil, err
 	}
-	return &response.Channel, nil
+	channel := &response.Channel
+
+	var errs []error
+	if strings.TrimSpace(opts.Topic) != "" {
+		if err := s.SetTopic(ctx, channel.ID, opts.Topic); err != nil {
+			errs = append(errs, fmt.Errorf("slack: set topic: %w", err))
+		}
+	}
+	if strings.TrimSpace(opts.Purpose) != "" {
+		if err := s.SetPurpose(ctx, channel.ID, opts.Purpose); err != nil {
+			errs = append(errs, fmt.Errorf("slack: set purpose: %w", err))
+		}
+	}
+	return channel, errors.Join(errs...)
+}
+
+// NormalizeChannelName converts name to a valid Slack channel name: lower
+// case letters, digits, hyphens and underscores, at most 80 characters.
+// Runs of other characters become a single hyphen and leading or trailing
+// hyphens are dropped, e.g. "Ops Alerts!" becomes "ops-alerts".
+func NormalizeChannelName(name string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(name) {
+		valid := unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+		if !valid {
+			pendingHyphen = b.Len() > 0
+			continue
+		}
+		if pendingHyphen {
+			b.WriteByte('-')
+			pendingHyphen = false
+		}
+		b.WriteRune(r)
+	}
+
+	normalized := []rune(b.String())
+	if len(normalized) > maxChannelNameLength {
+		normalized = normalized[:maxChannelNameLength]
+	}
+	return strings.TrimRight(string(normalized), "-")
+}
+
+// SetTopic sets channel topic.
+func (s *ConversationsService) SetTopic(ctx context.Context, channelID, topic string) error {
+	return s.setChannelText(ctx, "conversations.setTopic", "topic", channelID, topic)
+}
+
+// SetPurpose sets channel purpose (description).
+func (s *ConversationsService) SetPurpose(ctx context.Context, channelID, purpose string) error {
+	return s.setChannelText(ctx, "conversations.setPurpose", "purpose", channelID, purpose)
+}
+
+// MarkConversation moves the read cursor of a conversation to ts via conversations.mark.
+func (s *ConversationsService) MarkConversation(ctx context.Context, channelID, ts string) error {
+	if strings.TrimSpace(ts) == "" {
+		return errors.New("slack: message ts is required")
+	}
+	return s.setChannelText(ctx, "conversations.mark", "ts", channelID, ts)
+}
+
+func (s *ConversationsService) setChannelText(ctx context.Context, method, field, channelID, value string) error {
+	if strings.TrimSpace(channelID) == "" {
+		return errors.New("slack: channel ID is required")
+	}
+
+	form := url.Values{}
+	form.Set("channel", channelID)
+	form.Set(field, value)
+
+	req, err := s.client.newFormRequest(ctx, method, form)
+	if err != nil {
+		return err
+	}
+	return s.client.do(req, nil)
+}
+
+// SetupIncidentChannel creates a channel, then sets topic and purpose,
+// invites users and posts a pinned message. If the channel is created but a
+// later step fails, the channel is returned together with the joined errors
+// of all failed steps.
+func (s *ConversationsService) SetupIncidentChannel(ctx context.Context, opts IncidentChannelOptions) (*Conversation, error) {
+	channel, err := s.CreateConversationWithOptions(ctx, CreateConversationOptions{
+		Name:      opts.Name,
+		IsPrivate: opts.IsPrivate,
+		Topic:     opts.Topic,
+		Purpose:   opts.Purpose,
+	})
+	if channel == nil {
+		return nil, err
+	}
+
+	errs := []error{err}
+	if len(opts.UserIDs) > 0 {
+		if _, err := s.InviteUsersToChannel(ctx, opts.UserIDs, channel.ID); err != nil {
+			errs = append(errs, fmt.Errorf("slack: invite users: %w", err))
+		}
+	}
+	if strings.TrimSpace(opts.PinnedMessage) != "" {
+		if err := s.postPinned(ctx, channel.ID, opts.PinnedMessage); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return channel, errors.Join(errs...)
+}
+
+func (s *ConversationsService) postPinned(ctx context.Context, channelID, text string) error {
+	posted, err := s.client.Messages().PostMessage(ctx, &PostMessageRequest{Channel: channelID, Text: text})
+	if err != nil {
+		return fmt.Errorf("slack: post pinned message: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("channel", channelID)
+	form.Set("timestamp", posted.TS)
+	req, err := s.client.newFormRequest(ctx, "pins.add", form)
+	if err != nil {
+		return err
+	}
+	if err := s.client.do(req, nil); err != nil {
+		return fmt.Errorf("slack: pin message: %w", err)
+	}
+	return nil
 }
 
 // GetChannelByID returns conversation by channel ID.
@@ -194,6 +379,40 @@ func (s *ConversationsService) GetReplies(ctx context.Context, req *GetRepliesRe
 	return &response, nil
 }
 
+// GetConversationReplies returns all messages of a thread, following
+// conversations.replies cursors. The first message is the thread parent;
+// a parent repeated on later pages is skipped.
+func (s *ConversationsService) GetConversationReplies(ctx context.Context, channelID, threadTS string, opts RepliesOptions) ([]Message, error) {
+	req := &GetRepliesRequest{
+		Channel:            channelID,
+		TS:                 threadTS,
+		IncludeAllMetadata: opts.IncludeAllMetadata,
+		Inclusive:          opts.Inclusive,
+		Latest:             opts.Latest,
+		Oldest:             opts.Oldest,
+		Limit:              opts.Limit,
+	}
+
+	var messages []Message
+	for {
+		response, err := s.GetReplies(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, message := range response.Messages {
+			if len(messages) > 0 && message.TS == threadTS {
+				continue
+			}
+			messages = append(messages, message)
+		}
+
+		req.Cursor = strings.TrimSpace(response.ResponseMetadata.NextCursor)
+		if req.Cursor == "" {
+			return messages, nil
+		}
+	}
+}
+
 // InviteUsersToChannel invites users to a channel.
 func (s *ConversationsService) InviteUsersToChannel(ctx context.Context, userIDs []string, channelID string) (*Conversation, error) {
 	if strings.TrimSpace(channelID) == "" {
@@ -0,0 +1,331 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
+)
+
+func TestSetupIncidentChannel(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls = append(calls, r.URL.Path)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/conversations.create":
+			_ = r.ParseForm()
+			if r.Form.Get("name") != "inc-42" || r.Form.Get("is_private") != "true" {
+				t.Fatalf("unexpected create form: %v", r.Form)
+			}
+			_, _ = w.Write([]byte(`{"ok":true,"channel":{"id":"C42","name":"inc-42"}}`))
+		case "/conversations.setTopic":
+			_ = r.ParseForm()
+			if r.Form.Get("channel") != "C42" || r.Form.Get("topic") != "DB outage" {
+				t.Fatalf("unexpected topic form: %v", r.Form)
+			}
+			_, _ = w.Write([]byte(`{"ok":true}`))
+		case "/conversations.setPurpose":
+			_ = r.ParseForm()
+			if r.Form.Get("purpose") != "Coordinate recovery" {
+				t.Fatalf("unexpected purpose form: %v", r.Form)
+			}
+			_, _ = w.Write([]byte(`{"ok":true}`))
+		case "/conversations.invite":
+			_, _ = w.Write([]byte(`{"ok":false,"error":"user_not_found"}`))
+		case "/chat.postMessage":
+			_, _ = w.Write([]byte(`{"ok":true,"channel":"C42","ts":"111.222"}`))
+		case "/pins.add":
+			_ = r.ParseForm()
+			if r.Form.Get("channel") != "C42" || r.Form.Get("timestamp") != "111.222" {
+				t.Fatalf("unexpected pin form: %v", r.Form)
+			}
+			_, _ = w.Write([]byte(`{"ok":true}`))
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	channel, err := client.Conversations().SetupIncidentChannel(context.Background(), IncidentChannelOptions{
+		Name:          "inc-42",
+		IsPrivate:     true,
+		Topic:         "DB outage",
+		Purpose:       "Coordinate recovery",
+		UserIDs:       []string{"U404"},
+		PinnedMessage: "Runbook: https://example.com",
+	})
+	if channel == nil || channel.ID != "C42" {
+		t.Fatalf("expected created channel, got %+v", channel)
+	}
+	var slackErr *Error
+	if !errors.As(err, &slackErr) || slackErr.Code != "user_not_found" {
+		t.Fatalf("expected invite error, got %v", err)
+	}
+
+	want := []string{
+		"/conversations.create",
+		"/conversations.setTopic",
+		"/conversations.setPurpose",
+		"/conversations.invite",
+		"/chat.postMessage",
+		"/pins.add",
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Fatalf("unexpected call sequence: %v", calls)
+	}
+}
+
+func TestSetupIncidentChannelCreateFailure(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/conversations.create" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":false,"error":"name_taken"}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	channel, err := client.Conversations().SetupIncidentChannel(context.Background(), IncidentChannelOptions{Name: "inc-1", Topic: "x"})
+	if err == nil || channel != nil {
+		t.Fatalf("expected create failure, got channel=%+v err=%v", channel, err)
+	}
+}
+
+func TestCreateConversationWithOptionsNormalizesName(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls = append(calls, r.URL.Path)
+		mu.Unlock()
+
+		_ = r.ParseForm()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/conversations.create":
+			if r.Form.Get("name") != "ops-alerts" || r.Form.Get("team_id") != "T1" {
+				t.Fatalf("unexpected create form: %v", r.Form)
+			}
+			_, _ = w.Write([]byte(`{"ok":true,"channel":{"id":"C7","name":"ops-alerts"}}`))
+		case "/conversations.setTopic":
+			if r.Form.Get("channel") != "C7" || r.Form.Get("topic") != "Paging" {
+				t.Fatalf("unexpected topic form: %v", r.Form)
+			}
+			_, _ = w.Write([]byte(`{"ok":true}`))
+		case "/conversations.setPurpose":
+			_, _ = w.Write([]byte(`{"ok":false,"error":"too_long"}`))
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTeamID("T1"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	channel, err := client.Conversations().CreateConversationWithOptions(context.Background(), CreateConversationOptions{
+		Name:          "Ops Alerts!",
+		NormalizeName: true,
+		Topic:         "Paging",
+		Purpose:       "Alert routing",
+	})
+	if channel == nil || channel.ID != "C7" {
+		t.Fatalf("expected created channel, got %+v", channel)
+	}
+	var slackErr *Error
+	if !errors.As(err, &slackErr) || slackErr.Code != "too_long" {
+		t.Fatalf("expected purpose error, got %v", err)
+	}
+
+	want := []string{"/conversations.create", "/conversations.setTopic", "/conversations.setPurpose"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Fatalf("unexpected call sequence: %v", calls)
+	}
+
+	if _, err := client.Conversations().CreateConversationWithOptions(context.Background(), CreateConversationOptions{Name: "!!!", NormalizeName: true}); err == nil {
+		t.Fatalf("expected error for name that normalizes to empty")
+	}
+}
+
+func TestNormalizeChannelName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"Ops Alerts!":           "ops-alerts",
+		"ops-alerts":            "ops-alerts",
+		"  INC 42 -- DB  ":      "inc-42-db",
+		"team_payments":         "team_payments",
+		"Релиз 2.0":             "релиз-2-0",
+		strings.Repeat("a", 90): strings.Repeat("a", 80),
+	}
+	for input, want := range tests {
+		if got := NormalizeChannelName(input); got != want {
+			t.Fatalf("NormalizeChannelName(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestListConversationsValidatesTypesAndSendsLimit(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/conversations.list" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("limit") != "200" || q.Get("types") != "public_channel,im" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"channels":[{"id":"C1"},{"id":"D1"}]}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	channels, err := client.Conversations().ListConversations(context.Background(), &ListConversationsRequest{
+		Types: []string{"public_channel", "im"},
+		Limit: 200,
+	})
+	if err != nil {
+		t.Fatalf("ListConversations failed: %v", err)
+	}
+	if len(channels) != 2 {
+		t.Fatalf("unexpected channels: %+v", channels)
+	}
+
+	_, err = client.Conversations().GetConversationList(context.Background(), false, []string{"public_channels"})
+	if err == nil || !strings.Contains(err.Error(), "public_channels") {
+		t.Fatalf("expected unknown type error, got %v", err)
+	}
+}
+
+func TestGetConversationRepliesFollowsCursor(t *testing.T) {
+	t.Parallel()
+
+	var cursors []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/conversations.replies" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("channel") != "C1" || q.Get("ts") != "100.1" || q.Get("limit") != "2" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		cursors = append(cursors, q.Get("cursor"))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch q.Get("cursor") {
+		case "":
+			_, _ = w.Write([]byte(`{"ok":true,"has_more":true,"messages":[{"ts":"100.1","thread_ts":"100.1","text":"parent","reply_count":2},{"ts":"100.2","thread_ts":"100.1","text":"first"}],"response_metadata":{"next_cursor":"page-2"}}`))
+		case "page-2":
+			_, _ = w.Write([]byte(`{"ok":true,"has_more":false,"messages":[{"ts":"100.1","thread_ts":"100.1","text":"parent"},{"ts":"100.3","thread_ts":"100.1","text":"second"}],"response_metadata":{"next_cursor":""}}`))
+		default:
+			t.Fatalf("unexpected cursor: %q", q.Get("cursor"))
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	messages, err := client.Conversations().GetConversationReplies(context.Background(), "C1", "100.1", RepliesOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("GetConversationReplies failed: %v", err)
+	}
+	if !reflect.DeepEqual(cursors, []string{"", "page-2"}) {
+		t.Fatalf("unexpected cursors: %v", cursors)
+	}
+	var texts []string
+	for _, message := range messages {
+		texts = append(texts, message.Text)
+	}
+	if !reflect.DeepEqual(texts, []string{"parent", "first", "second"}) {
+		t.Fatalf("unexpected messages: %v", texts)
+	}
+
+	if _, err := client.Conversations().GetConversationReplies(context.Background(), "", "100.1", RepliesOptions{}); err == nil {
+		t.Fatalf("expected error for empty channel")
+	}
+	if _, err := client.Conversations().GetConversationReplies(context.Background(), "C1", " ", RepliesOptions{}); err == nil {
+		t.Fatalf("expected error for empty thread timestamp")
+	}
+}
+
+func TestMarkConversation(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/conversations.mark" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		_ = r.ParseForm()
+		w.Header().Set("Content-Type", "application/json")
+		if r.PostForm.Get("channel") == "C-outside" {
+			_, _ = w.Write([]byte(`{"ok":false,"error":"not_in_channel"}`))
+			return
+		}
+		if r.PostForm.Get("channel") != "C1" || r.PostForm.Get("ts") != "1700000000.000100" {
+			t.Fatalf("unexpected form: %v", r.PostForm)
+		}
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if err := client.Conversations().MarkConversation(context.Background(), "C1", "1700000000.000100"); err != nil {
+		t.Fatalf("MarkConversation failed: %v", err)
+	}
+
+	err = client.Conversations().MarkConversation(context.Background(), "C-outside", "1700000000.000100")
+	var slackErr *Error
+	if !errors.As(err, &slackErr) || slackErr.Code != "not_in_channel" {
+		t.Fatalf("expected not_in_channel error, got %v", err)
+	}
+
+	if err := client.Conversations().MarkConversation(context.Background(), "C1", ""); err == nil {
+		t.Fatalf("expected error for empty ts")
+	}
+}
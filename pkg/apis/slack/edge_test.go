@@ -49,6 +49,39 @@ func TestListUserGroupsAddsTeamID(t *testing.T) {
 	}
 }
 
+func TestListUserGroupsWithOptionsDecodesUsers(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/usergroups.list" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("include_disabled") != "true" || q.Get("include_users") != "true" || q.Get("include_count") != "" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"usergroups":[{"id":"S1","name":"Ops","users":["U1","U2"],"user_count":2}]}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	groups, err := client.UserGroups().ListUserGroupsWithOptions(context.Background(), &ListUserGroupsOptions{
+		IncludeDisabled: true,
+		IncludeUsers:    true,
+	})
+	if err != nil {
+		t.Fatalf("ListUserGroupsWithOptions failed: %v", err)
+	}
+	if len(groups) != 1 || len(groups[0].Users) != 2 || groups[0].Users[1] != "U2" || groups[0].UserCount != 2 {
+		t.Fatalf("unexpected groups response: %+v", groups)
+	}
+}
+
 func TestInviteUsersToChannelEncodesUsersList(t *testing.T) {
 	t.Parallel()
 
@@ -1,6 +1,15 @@
 package slack
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrExpiredTrigger is returned (wrapping *Error) when views.open fails with
+// expired_trigger_id. Trigger IDs are single-use and expire after 3 seconds,
+// so the request cannot be retried; ask the user for a fresh interaction.
+var ErrExpiredTrigger = errors.New("slack: trigger ID expired")
 
 // Error describes Slack API errors when JSON contains ok=false.
 type Error struct {
@@ -20,3 +29,37 @@ func (e *Error) Error() string {
 	}
 	return fmt.Sprintf("slack: api error code=%s", e.Code)
 }
+
+// IsMissingScope reports whether Slack rejected the call with missing_scope.
+func (e *Error) IsMissingScope() bool {
+	return e != nil && e.Code == "missing_scope"
+}
+
+// MissingScopes returns scopes listed in Needed that are absent from
+// Provided, i.e. the OAuth scopes to add to the app.
+func (e *Error) MissingScopes() []string {
+	if e == nil {
+		return nil
+	}
+	provided := make(map[string]struct{})
+	for _, scope := range splitScopes(e.Provided) {
+		provided[scope] = struct{}{}
+	}
+	var missing []string
+	for _, scope := range splitScopes(e.Needed) {
+		if _, ok := provided[scope]; !ok {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
+
+func splitScopes(scopes string) []string {
+	var out []string
+	for _, scope := range strings.Split(scopes, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			out = append(out, scope)
+		}
+	}
+	return out
+}
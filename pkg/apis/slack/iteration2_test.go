@@ -221,6 +221,42 @@ func TestOpenViewReturnsSlackError(t *testing.T) {
 	if slackErr.Code != "invalid_trigger" {
 		t.Fatalf("unexpected error code: %q", slackErr.Code)
 	}
+	if errors.Is(err, ErrExpiredTrigger) {
+		t.Fatalf("invalid_trigger must not match ErrExpiredTrigger")
+	}
+}
+
+func TestOpenViewExpiredTrigger(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":false,"error":"expired_trigger_id"}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithToken("xoxb-test"),
+		WithTransport(transport.New()),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	_, err = client.Views().OpenView(context.Background(), "12345.98765.abcd", &ModalViewRequest{Type: "modal"})
+	if !errors.Is(err, ErrExpiredTrigger) {
+		t.Fatalf("expected ErrExpiredTrigger, got %v", err)
+	}
+	var slackErr *Error
+	if !errors.As(err, &slackErr) || slackErr.Code != "expired_trigger_id" {
+		t.Fatalf("expected wrapped slack.Error, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected no automatic retry, got %d calls", calls)
+	}
 }
 
 func TestShareCanvasValidation(t *testing.T) {
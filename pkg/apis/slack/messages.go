@@ -2,8 +2,13 @@ package slack
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // MessagesService provides Slack messaging operations.
@@ -22,6 +27,14 @@ func (s *MessagesService) PostMessage(ctx context.Context, req *PostMessageReque
 	if strings.TrimSpace(req.Text) == "" && len(req.Blocks) == 0 && len(req.Attachments) == 0 {
 		return nil, errors.New("slack: text, blocks, or attachments is required")
 	}
+	if req.ReplyBroadcast && strings.TrimSpace(req.ThreadTS) == "" {
+		return nil, errors.New("slack: thread_ts is required for reply_broadcast")
+	}
+	if s.client.markdown && req.Text != "" {
+		converted := *req
+		converted.Text = ToMrkdwn(req.Text)
+		req = &converted
+	}
 
 	httpReq, err := s.client.newJSONRequest(ctx, "chat.postMessage", req)
 	if err != nil {
@@ -88,3 +101,247 @@ func (s *MessagesService) UpdateMessage(ctx context.Context, req *UpdateMessageR
 	}
 	return &response, nil
 }
+
+// PostBlocks posts a Block Kit message. fallbackText is used in notifications
+// and may be empty.
+func (s *MessagesService) PostBlocks(ctx context.Context, channelID string, blocks []map[string]any, fallbackText string) (*PostedMessage, error) {
+	if len(blocks) == 0 {
+		return nil, errors.New("slack: blocks are required")
+	}
+
+	items := make([]any, 0, len(blocks))
+	for _, block := range blocks {
+		items = append(items, block)
+	}
+
+	return s.PostMessage(ctx, &PostMessageRequest{
+		Channel: channelID,
+		Text:    fallbackText,
+		Blocks:  items,
+	})
+}
+
+// PostMeMessage posts a /me style message via chat.meMessage.
+func (s *MessagesService) PostMeMessage(ctx context.Context, channelID, text string) (*PostedMessage, error) {
+	if strings.TrimSpace(channelID) == "" {
+		return nil, errors.New("slack: channel ID is required")
+	}
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("slack: text is required")
+	}
+
+	httpReq, err := s.client.newJSONRequest(ctx, "chat.meMessage", map[string]string{
+		"channel": channelID,
+		"text":    text,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response PostedMessage
+	if err := s.client.do(httpReq, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// Unfurl provides custom unfurls for links in a message via chat.unfurl.
+// unfurls is keyed by URL; each value is an attachment or blocks payload.
+func (s *MessagesService) Unfurl(ctx context.Context, channelID, ts string, unfurls map[string]map[string]any) error {
+	if strings.TrimSpace(channelID) == "" {
+		return errors.New("slack: channel ID is required")
+	}
+	if strings.TrimSpace(ts) == "" {
+		return errors.New("slack: message ts is required")
+	}
+	if len(unfurls) == 0 {
+		return errors.New("slack: unfurls are required")
+	}
+
+	encoded, err := json.Marshal(unfurls)
+	if err != nil {
+		return fmt.Errorf("slack: marshal unfurls: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("channel", channelID)
+	form.Set("ts", ts)
+	form.Set("unfurls", string(encoded))
+
+	httpReq, err := s.client.newFormRequest(ctx, "chat.unfurl", form)
+	if err != nil {
+		return err
+	}
+	return s.client.do(httpReq, nil)
+}
+
+// GetMessageByPermalink returns the message a Slack permalink points to and
+// its channel ID, e.g. for https://acme.slack.com/archives/C123/p1700000000123456.
+// Top-level messages are read with conversations.history; thread replies
+// (permalinks with thread_ts) with conversations.replies.
+func (s *MessagesService) GetMessageByPermalink(ctx context.Context, permalink string) (*Message, string, error) {
+	channelID, ts, threadTS, err := parsePermalink(permalink)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var response *HistoryResponse
+	if threadTS != "" && threadTS != ts {
+		response, err = s.client.Conversations().GetReplies(ctx, &GetRepliesRequest{
+			Channel:   channelID,
+			TS:        threadTS,
+			Oldest:    ts,
+			Latest:    ts,
+			Inclusive: true,
+		})
+	} else {
+		response, err = s.client.Conversations().GetHistory(ctx, &GetHistoryRequest{
+			Channel:   channelID,
+			Latest:    ts,
+			Inclusive: true,
+			Limit:     1,
+		})
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	for i := range response.Messages {
+		if response.Messages[i].TS == ts {
+			return &response.Messages[i], channelID, nil
+		}
+	}
+	return nil, "", fmt.Errorf("slack: message %s not found in channel %s", ts, channelID)
+}
+
+// parsePermalink extracts channel ID, message ts and optional thread_ts from
+// a permalink of the form https://<workspace>.slack.com/archives/<channel>/p<ts digits>.
+func parsePermalink(permalink string) (channelID, ts, threadTS string, err error) {
+	parsed, err := url.Parse(strings.TrimSpace(permalink))
+	if err != nil {
+		return "", "", "", fmt.Errorf("slack: parse permalink: %w", err)
+	}
+	if (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return "", "", "", fmt.Errorf("slack: permalink %q must be an absolute URL", permalink)
+	}
+
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if len(segments) != 3 || segments[0] != "archives" || segments[1] == "" {
+		return "", "", "", fmt.Errorf("slack: permalink %q is not of the form /archives/<channel>/p<ts>", permalink)
+	}
+	digits, ok := strings.CutPrefix(segments[2], "p")
+	if !ok || len(digits) <= 6 || strings.Trim(digits, "0123456789") != "" {
+		return "", "", "", fmt.Errorf("slack: permalink %q has an invalid message id %q", permalink, segments[2])
+	}
+
+	ts = digits[:len(digits)-6] + "." + digits[len(digits)-6:]
+	return segments[1], ts, parsed.Query().Get("thread_ts"), nil
+}
+
+// ScheduleMessage queues a text message for postAt via chat.scheduleMessage.
+func (s *MessagesService) ScheduleMessage(ctx context.Context, channelID, text string, postAt time.Time) (*ScheduledMessage, error) {
+	if strings.TrimSpace(channelID) == "" {
+		return nil, errors.New("slack: channel ID is required")
+	}
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("slack: text is required")
+	}
+	if postAt.IsZero() {
+		return nil, errors.New("slack: post time is required")
+	}
+
+	form := url.Values{}
+	form.Set("channel", channelID)
+	form.Set("text", text)
+	form.Set("post_at", strconv.FormatInt(postAt.Unix(), 10))
+
+	httpReq, err := s.client.newFormRequest(ctx, "chat.scheduleMessage", form)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Channel            string `json:"channel"`
+		ScheduledMessageID string `json:"scheduled_message_id"`
+		PostAt             int64  `json:"post_at"`
+	}
+	if err := s.client.do(httpReq, &response); err != nil {
+		return nil, err
+	}
+	return &ScheduledMessage{
+		ID:      response.ScheduledMessageID,
+		Channel: response.Channel,
+		Text:    text,
+		PostAt:  time.Unix(response.PostAt, 0).UTC(),
+	}, nil
+}
+
+// ListScheduledMessages returns pending scheduled messages from
+// chat.scheduledMessages.list, following cursors until the last page.
+func (s *MessagesService) ListScheduledMessages(ctx context.Context, req *ListScheduledMessagesRequest) ([]ScheduledMessage, error) {
+	if req == nil {
+		req = &ListScheduledMessagesRequest{}
+	}
+
+	var (
+		cursor string
+		all    []ScheduledMessage
+	)
+	for {
+		params := url.Values{}
+		if strings.TrimSpace(req.Channel) != "" {
+			params.Set("channel", req.Channel)
+		}
+		if req.Latest != "" {
+			params.Set("latest", req.Latest)
+		}
+		if req.Oldest != "" {
+			params.Set("oldest", req.Oldest)
+		}
+		if req.Limit > 0 {
+			params.Set("limit", strconv.Itoa(req.Limit))
+		}
+		if cursor != "" {
+			params.Set("cursor", cursor)
+		}
+		s.client.withTeamID(params)
+
+		httpReq, err := s.client.newGetRequest(ctx, "chat.scheduledMessages.list", params)
+		if err != nil {
+			return nil, err
+		}
+
+		var response struct {
+			ScheduledMessages []ScheduledMessage `json:"scheduled_messages"`
+			ResponseMetadata  ResponseMetadata   `json:"response_metadata"`
+		}
+		if err := s.client.do(httpReq, &response); err != nil {
+			return nil, err
+		}
+		all = append(all, response.ScheduledMessages...)
+
+		cursor = strings.TrimSpace(response.ResponseMetadata.NextCursor)
+		if cursor == "" {
+			return all, nil
+		}
+	}
+}
+
+// DeleteScheduledMessage removes a pending scheduled message via chat.deleteScheduledMessage.
+func (s *MessagesService) DeleteScheduledMessage(ctx context.Context, channelID, scheduledMessageID string) error {
+	if strings.TrimSpace(channelID) == "" {
+		return errors.New("slack: channel ID is required")
+	}
+	if strings.TrimSpace(scheduledMessageID) == "" {
+		return errors.New("slack: scheduled message ID is required")
+	}
+
+	form := url.Values{}
+	form.Set("channel", channelID)
+	form.Set("scheduled_message_id", scheduledMessageID)
+
+	httpReq, err := s.client.newFormRequest(ctx, "chat.deleteScheduledMessage", form)
+	if err != nil {
+		return err
+	}
+	return s.client.do(httpReq, nil)
+}
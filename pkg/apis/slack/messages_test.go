@@ -0,0 +1,401 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
+)
+
+func TestPostMessageInThreadReturnsThreadTS(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/chat.postMessage" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode json: %v", err)
+		}
+		if payload["thread_ts"] != "111.222" {
+			t.Fatalf("unexpected thread_ts: %v", payload["thread_ts"])
+		}
+		if payload["reply_broadcast"] != true {
+			t.Fatalf("expected reply_broadcast=true, got %v", payload["reply_broadcast"])
+		}
+		if payload["unfurl_links"] != false {
+			t.Fatalf("expected unfurl_links=false, got %v", payload["unfurl_links"])
+		}
+		if _, ok := payload["unfurl_media"]; ok {
+			t.Fatalf("expected unfurl_media to be omitted")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"channel":"C1","ts":"333.444","message":{"type":"message","bot_id":"B42","text":"update","ts":"333.444","thread_ts":"111.222"}}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	unfurl := false
+	result, err := client.Messages().PostMessage(context.Background(), &PostMessageRequest{
+		Channel:        "C1",
+		Text:           "update",
+		ThreadTS:       "111.222",
+		ReplyBroadcast: true,
+		UnfurlLinks:    &unfurl,
+	})
+	if err != nil {
+		t.Fatalf("PostMessage failed: %v", err)
+	}
+	if result.TS != "333.444" {
+		t.Fatalf("unexpected TS: %q", result.TS)
+	}
+	if result.Message.ThreadTS != "111.222" {
+		t.Fatalf("unexpected message.thread_ts: %q", result.Message.ThreadTS)
+	}
+	if result.Message.BotID != "B42" {
+		t.Fatalf("unexpected message.bot_id: %q", result.Message.BotID)
+	}
+}
+
+func TestPostMessageReplyBroadcastRequiresThread(t *testing.T) {
+	t.Parallel()
+
+	client, err := NewClient(WithToken("xoxb-test"))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	_, err = client.Messages().PostMessage(context.Background(), &PostMessageRequest{
+		Channel:        "C1",
+		Text:           "hello",
+		ReplyBroadcast: true,
+	})
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestPostBlocks(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/chat.postMessage" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode json: %v", err)
+		}
+		if payload["channel"] != "C1" || payload["text"] != "Deploy finished" {
+			t.Fatalf("unexpected payload: %v", payload)
+		}
+		blocks, ok := payload["blocks"].([]any)
+		if !ok || len(blocks) != 1 {
+			t.Fatalf("unexpected blocks: %v", payload["blocks"])
+		}
+		block, _ := blocks[0].(map[string]any)
+		if block["type"] != "section" {
+			t.Fatalf("unexpected block: %v", block)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"channel":"C1","ts":"1.1"}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	result, err := client.Messages().PostBlocks(context.Background(), "C1", []map[string]any{
+		{"type": "section", "text": map[string]any{"type": "mrkdwn", "text": "*Deploy* finished"}},
+	}, "Deploy finished")
+	if err != nil {
+		t.Fatalf("PostBlocks failed: %v", err)
+	}
+	if result.TS != "1.1" {
+		t.Fatalf("unexpected TS: %q", result.TS)
+	}
+
+	if _, err := client.Messages().PostBlocks(context.Background(), "C1", []map[string]any{}, "x"); err == nil {
+		t.Fatalf("expected error for empty blocks")
+	}
+}
+
+func TestPostMeMessage(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/chat.meMessage" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode json: %v", err)
+		}
+		if payload["channel"] != "C1" || payload["text"] != "is deploying" {
+			t.Fatalf("unexpected payload: %v", payload)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"channel":"C1","ts":"2.2"}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	result, err := client.Messages().PostMeMessage(context.Background(), "C1", "is deploying")
+	if err != nil {
+		t.Fatalf("PostMeMessage failed: %v", err)
+	}
+	if result.Channel != "C1" || result.TS != "2.2" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestUnfurlEncodesUnfurlsAsJSONString(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/chat.unfurl" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/x-www-form-urlencoded" {
+			t.Fatalf("unexpected content type: %q", got)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("parse form: %v", err)
+		}
+		if r.Form.Get("channel") != "C1" || r.Form.Get("ts") != "123.456" {
+			t.Fatalf("unexpected form: %v", r.Form)
+		}
+		var unfurls map[string]map[string]any
+		if err := json.Unmarshal([]byte(r.Form.Get("unfurls")), &unfurls); err != nil {
+			t.Fatalf("unfurls is not a JSON string: %v", err)
+		}
+		if unfurls["https://example.com/inc/1"]["text"] != "INC-1: DB outage" {
+			t.Fatalf("unexpected unfurls: %v", unfurls)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	err = client.Messages().Unfurl(context.Background(), "C1", "123.456", map[string]map[string]any{
+		"https://example.com/inc/1": {"text": "INC-1: DB outage"},
+	})
+	if err != nil {
+		t.Fatalf("Unfurl failed: %v", err)
+	}
+
+	if err := client.Messages().Unfurl(context.Background(), "C1", "123.456", nil); err == nil {
+		t.Fatalf("expected error for empty unfurls")
+	}
+	if err := client.Messages().Unfurl(context.Background(), "C1", " ", map[string]map[string]any{"u": {}}); err == nil {
+		t.Fatalf("expected error for empty ts")
+	}
+}
+
+func TestPostMessageWithMarkdownConversion(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		if payload["text"] != "*Outage* see <https://status.example|status>" {
+			t.Fatalf("unexpected text: %v", payload["text"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"channel":"C1","ts":"1.1"}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTransport(transport.New()), WithMarkdownConversion())
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	req := &PostMessageRequest{Channel: "C1", Text: "**Outage** see [status](https://status.example)"}
+	if _, err := client.Messages().PostMessage(context.Background(), req); err != nil {
+		t.Fatalf("PostMessage failed: %v", err)
+	}
+	if req.Text != "**Outage** see [status](https://status.example)" {
+		t.Fatalf("expected caller request to stay unchanged, got %q", req.Text)
+	}
+}
+
+func TestScheduledMessagesLifecycle(t *testing.T) {
+	t.Parallel()
+
+	var listCursors []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/chat.scheduleMessage":
+			_ = r.ParseForm()
+			if r.PostForm.Get("channel") != "C1" || r.PostForm.Get("text") != "standup" || r.PostForm.Get("post_at") != "1700000000" {
+				t.Fatalf("unexpected schedule form: %v", r.PostForm)
+			}
+			_, _ = w.Write([]byte(`{"ok":true,"channel":"C1","scheduled_message_id":"Q1","post_at":1700000000}`))
+		case "/chat.scheduledMessages.list":
+			q := r.URL.Query()
+			if q.Get("channel") != "C1" || q.Get("oldest") != "1699999999" || q.Get("latest") != "1800000000" || q.Get("limit") != "1" {
+				t.Fatalf("unexpected list query: %s", r.URL.RawQuery)
+			}
+			listCursors = append(listCursors, q.Get("cursor"))
+			switch q.Get("cursor") {
+			case "":
+				_, _ = w.Write([]byte(`{"ok":true,"scheduled_messages":[{"id":"Q1","channel_id":"C1","post_at":1700000000,"date_created":1690000000,"text":"standup"}],"response_metadata":{"next_cursor":"next-1"}}`))
+			case "next-1":
+				_, _ = w.Write([]byte(`{"ok":true,"scheduled_messages":[{"id":"Q2","channel_id":"C1","post_at":1700003600,"date_created":1690000000,"text":"retro"}],"response_metadata":{"next_cursor":""}}`))
+			default:
+				t.Fatalf("unexpected cursor: %q", q.Get("cursor"))
+			}
+		case "/chat.deleteScheduledMessage":
+			_ = r.ParseForm()
+			if r.PostForm.Get("channel") != "C1" || r.PostForm.Get("scheduled_message_id") != "Q1" {
+				t.Fatalf("unexpected delete form: %v", r.PostForm)
+			}
+			_, _ = w.Write([]byte(`{"ok":true}`))
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	messages := client.Messages()
+
+	scheduled, err := messages.ScheduleMessage(context.Background(), "C1", "standup", time.Unix(1700000000, 0))
+	if err != nil {
+		t.Fatalf("ScheduleMessage failed: %v", err)
+	}
+	if scheduled.ID != "Q1" || !scheduled.PostAt.Equal(time.Unix(1700000000, 0)) {
+		t.Fatalf("unexpected scheduled message: %+v", scheduled)
+	}
+
+	list, err := messages.ListScheduledMessages(context.Background(), &ListScheduledMessagesRequest{
+		Channel: "C1",
+		Oldest:  "1699999999",
+		Latest:  "1800000000",
+		Limit:   1,
+	})
+	if err != nil {
+		t.Fatalf("ListScheduledMessages failed: %v", err)
+	}
+	if len(listCursors) != 2 || listCursors[1] != "next-1" {
+		t.Fatalf("unexpected cursors: %v", listCursors)
+	}
+	if len(list) != 2 || list[0].ID != "Q1" || list[1].Text != "retro" || list[1].Channel != "C1" {
+		t.Fatalf("unexpected scheduled messages: %+v", list)
+	}
+	if !list[1].PostAt.Equal(time.Date(2023, time.November, 14, 23, 13, 20, 0, time.UTC)) {
+		t.Fatalf("unexpected post_at: %s", list[1].PostAt)
+	}
+	if list[0].DateCreated.Unix() != 1690000000 {
+		t.Fatalf("unexpected date_created: %s", list[0].DateCreated)
+	}
+
+	encoded, err := json.Marshal(list[1])
+	if err != nil {
+		t.Fatalf("marshal scheduled message: %v", err)
+	}
+	var wire map[string]any
+	if err := json.Unmarshal(encoded, &wire); err != nil {
+		t.Fatalf("unmarshal wire scheduled message: %v", err)
+	}
+	if wire["channel_id"] != "C1" || wire["post_at"] != float64(1700003600) {
+		t.Fatalf("unexpected wire scheduled message: %s", encoded)
+	}
+	var decoded ScheduledMessage
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("round-trip scheduled message: %v", err)
+	}
+	if decoded != list[1] {
+		t.Fatalf("scheduled message did not round-trip: %+v != %+v", decoded, list[1])
+	}
+
+	if err := messages.DeleteScheduledMessage(context.Background(), "C1", "Q1"); err != nil {
+		t.Fatalf("DeleteScheduledMessage failed: %v", err)
+	}
+	if err := messages.DeleteScheduledMessage(context.Background(), "C1", " "); err == nil {
+		t.Fatalf("expected error for empty scheduled message ID")
+	}
+}
+
+func TestGetMessageByPermalink(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/conversations.history":
+			if q.Get("channel") != "C024BE91L" || q.Get("latest") != "1700000000.123456" || q.Get("inclusive") != "true" || q.Get("limit") != "1" {
+				t.Fatalf("unexpected history query: %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"ok":true,"messages":[{"type":"message","user":"U1","text":"deploy done","ts":"1700000000.123456"}]}`))
+		case "/conversations.replies":
+			if q.Get("channel") != "C024BE91L" || q.Get("ts") != "1700000000.000100" || q.Get("oldest") != "1700000050.000200" || q.Get("latest") != "1700000050.000200" {
+				t.Fatalf("unexpected replies query: %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"ok":true,"messages":[{"text":"parent","ts":"1700000000.000100"},{"text":"reply","ts":"1700000050.000200","thread_ts":"1700000000.000100"}]}`))
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	msg, channelID, err := client.Messages().GetMessageByPermalink(context.Background(), "https://acme.slack.com/archives/C024BE91L/p1700000000123456")
+	if err != nil {
+		t.Fatalf("GetMessageByPermalink failed: %v", err)
+	}
+	if channelID != "C024BE91L" || msg.TS != "1700000000.123456" || msg.Text != "deploy done" {
+		t.Fatalf("unexpected result: channel=%q message=%+v", channelID, msg)
+	}
+
+	msg, _, err = client.Messages().GetMessageByPermalink(context.Background(), "https://acme.slack.com/archives/C024BE91L/p1700000050000200?thread_ts=1700000000.000100&cid=C024BE91L")
+	if err != nil {
+		t.Fatalf("GetMessageByPermalink for reply failed: %v", err)
+	}
+	if msg.Text != "reply" {
+		t.Fatalf("expected thread reply, got %+v", msg)
+	}
+
+	for _, invalid := range []string{
+		"",
+		"/archives/C024BE91L/p1700000000123456",
+		"https://acme.slack.com/messages/C024BE91L/p1700000000123456",
+		"https://acme.slack.com/archives/C024BE91L/1700000000123456",
+		"https://acme.slack.com/archives/C024BE91L/p12x",
+	} {
+		if _, _, err := client.Messages().GetMessageByPermalink(context.Background(), invalid); err == nil {
+			t.Fatalf("expected error for permalink %q", invalid)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package slack
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // ResponseMetadata is Slack cursor pagination metadata.
 type ResponseMetadata struct {
@@ -14,6 +17,17 @@ type UserGroup struct {
 	Name        string `json:"name,omitempty"`
 	Handle      string `json:"handle,omitempty"`
 	Description string `json:"description,omitempty"`
+	// Users is populated when listing with IncludeUsers.
+	Users []string `json:"users,omitempty"`
+	// UserCount is populated when listing with IncludeCount.
+	UserCount int `json:"user_count,omitempty"`
+}
+
+// ListUserGroupsOptions contains parameters for usergroups.list.
+type ListUserGroupsOptions struct {
+	IncludeDisabled bool
+	IncludeCount    bool
+	IncludeUsers    bool
 }
 
 // ListUserGroupUsersRequest contains parameters for usergroups.users.list.
@@ -50,6 +64,46 @@ type Conversation struct {
 	ContextTeamID string `json:"context_team_id,omitempty"`
 }
 
+// UserConversationsOptions controls users.conversations; all pages are fetched.
+type UserConversationsOptions struct {
+	ExcludeArchived bool
+	// Types is any of public_channel, private_channel, mpim, im.
+	Types []string
+	// Limit is the page size per request.
+	Limit int
+}
+
+// ListConversationsRequest contains parameters for conversations.list.
+type ListConversationsRequest struct {
+	ExcludeArchived bool
+	// Types filters by public_channel, private_channel, mpim or im.
+	Types []string
+	// Limit is the page size (Slack's limit param); pages are still followed.
+	Limit int
+}
+
+// CreateConversationOptions configures CreateConversationWithOptions.
+type CreateConversationOptions struct {
+	Name      string
+	IsPrivate bool
+	// NormalizeName applies NormalizeChannelName to Name before creating.
+	NormalizeName bool
+	// Topic and Purpose are set right after creation when non-empty.
+	Topic   string
+	Purpose string
+}
+
+// IncidentChannelOptions configures SetupIncidentChannel.
+type IncidentChannelOptions struct {
+	Name      string
+	IsPrivate bool
+	Topic     string
+	Purpose   string
+	UserIDs   []string
+	// PinnedMessage is posted to the channel and pinned when non-empty.
+	PinnedMessage string
+}
+
 // User is Slack user DTO.
 type User struct {
 	ID                     string      `json:"id"`
@@ -131,6 +185,7 @@ type Message struct {
 	Type       string `json:"type,omitempty"`
 	SubType    string `json:"subtype,omitempty"`
 	User       string `json:"user,omitempty"`
+	BotID      string `json:"bot_id,omitempty"`
 	Text       string `json:"text,omitempty"`
 	TS         string `json:"ts,omitempty"`
 	ThreadTS   string `json:"thread_ts,omitempty"`
@@ -160,6 +215,16 @@ type GetRepliesRequest struct {
 	Limit              int    `json:"limit,omitempty"`
 }
 
+// RepliesOptions controls GetConversationReplies; zero value fetches the whole thread.
+type RepliesOptions struct {
+	IncludeAllMetadata bool
+	Inclusive          bool
+	Latest             string
+	Oldest             string
+	// Limit is the page size per request.
+	Limit int
+}
+
 // HistoryResponse is the response from conversations.history and conversations.replies.
 type HistoryResponse struct {
 	Messages         []Message        `json:"messages"`
@@ -185,14 +250,73 @@ type EphemeralPostResult struct {
 // Blocks and Attachments accept any JSON-serializable structs
 // (e.g. slack-go block types, maps, or custom structs).
 type PostMessageRequest struct {
-	Channel     string         `json:"channel"`
-	Text        string         `json:"text,omitempty"`
-	Blocks      []any          `json:"blocks,omitempty"`
-	Attachments []any          `json:"attachments,omitempty"`
-	ThreadTS    string         `json:"thread_ts,omitempty"`
-	Metadata    map[string]any `json:"metadata,omitempty"`
-	UnfurlLinks *bool          `json:"unfurl_links,omitempty"`
-	UnfurlMedia *bool          `json:"unfurl_media,omitempty"`
+	Channel     string `json:"channel"`
+	Text        string `json:"text,omitempty"`
+	Blocks      []any  `json:"blocks,omitempty"`
+	Attachments []any  `json:"attachments,omitempty"`
+	ThreadTS    string `json:"thread_ts,omitempty"`
+	// ReplyBroadcast also posts a thread reply to the channel (requires ThreadTS).
+	ReplyBroadcast bool           `json:"reply_broadcast,omitempty"`
+	Metadata       map[string]any `json:"metadata,omitempty"`
+	UnfurlLinks    *bool          `json:"unfurl_links,omitempty"`
+	UnfurlMedia    *bool          `json:"unfurl_media,omitempty"`
+}
+
+// ScheduledMessage is a message queued with chat.scheduleMessage.
+// PostAt and DateCreated are Unix seconds on the wire.
+type ScheduledMessage struct {
+	ID          string    `json:"id"`
+	Channel     string    `json:"channel_id"`
+	Text        string    `json:"text,omitempty"`
+	PostAt      time.Time `json:"post_at"`
+	DateCreated time.Time `json:"date_created"`
+}
+
+type scheduledMessageWire struct {
+	ID          string `json:"id"`
+	ChannelID   string `json:"channel_id"`
+	Text        string `json:"text,omitempty"`
+	PostAt      int64  `json:"post_at"`
+	DateCreated int64  `json:"date_created"`
+}
+
+// MarshalJSON encodes post_at/date_created as Unix seconds, as Slack does.
+func (m ScheduledMessage) MarshalJSON() ([]byte, error) {
+	raw := scheduledMessageWire{ID: m.ID, ChannelID: m.Channel, Text: m.Text}
+	if !m.PostAt.IsZero() {
+		raw.PostAt = m.PostAt.Unix()
+	}
+	if !m.DateCreated.IsZero() {
+		raw.DateCreated = m.DateCreated.Unix()
+	}
+	return json.Marshal(raw)
+}
+
+// UnmarshalJSON decodes Slack Unix-seconds post_at/date_created into time.Time.
+func (m *ScheduledMessage) UnmarshalJSON(data []byte) error {
+	var raw scheduledMessageWire
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*m = ScheduledMessage{ID: raw.ID, Channel: raw.ChannelID, Text: raw.Text}
+	if raw.PostAt > 0 {
+		m.PostAt = time.Unix(raw.PostAt, 0).UTC()
+	}
+	if raw.DateCreated > 0 {
+		m.DateCreated = time.Unix(raw.DateCreated, 0).UTC()
+	}
+	return nil
+}
+
+// ListScheduledMessagesRequest contains filters for chat.scheduledMessages.list.
+// All pages are fetched.
+type ListScheduledMessagesRequest struct {
+	Channel string
+	// Latest and Oldest bound post_at as Unix timestamps.
+	Latest string
+	Oldest string
+	// Limit is the page size per request.
+	Limit int
 }
 
 // PostEphemeralRequest is the payload for chat.postEphemeral.
@@ -253,3 +377,40 @@ type Canvas struct {
 	ID    string `json:"id,omitempty"`
 	Title string `json:"title,omitempty"`
 }
+
+// Team is a minimal Slack workspace DTO returned by team.info.
+type Team struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name,omitempty"`
+	Domain      string   `json:"domain,omitempty"`
+	EmailDomain string   `json:"email_domain,omitempty"`
+	Icon        TeamIcon `json:"icon,omitempty"`
+}
+
+// TeamIcon contains workspace icon URLs.
+type TeamIcon struct {
+	Image34      string `json:"image_34,omitempty"`
+	Image44      string `json:"image_44,omitempty"`
+	Image68      string `json:"image_68,omitempty"`
+	Image88      string `json:"image_88,omitempty"`
+	Image102     string `json:"image_102,omitempty"`
+	Image132     string `json:"image_132,omitempty"`
+	Image230     string `json:"image_230,omitempty"`
+	ImageDefault bool   `json:"image_default,omitempty"`
+}
+
+// Reminder is a Slack reminder DTO.
+type Reminder struct {
+	ID         string `json:"id"`
+	Creator    string `json:"creator,omitempty"`
+	User       string `json:"user,omitempty"`
+	Text       string `json:"text,omitempty"`
+	Recurring  bool   `json:"recurring,omitempty"`
+	Time       int64  `json:"time,omitempty"`
+	CompleteTS int64  `json:"complete_ts,omitempty"`
+}
+
+// Complete reports whether a one-time reminder has been marked complete.
+func (r Reminder) Complete() bool {
+	return r.CompleteTS > 0
+}
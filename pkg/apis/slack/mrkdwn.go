@@ -0,0 +1,73 @@
+package slack
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	mdInlineCode = regexp.MustCompile("`[^`\n]*`")
+	mdHeading    = regexp.MustCompile(`(?m)^#{1,6}[ \t]+(.+?)[ \t]*#*[ \t]*$`)
+	mdBullet     = regexp.MustCompile(`(?m)^([ \t]*)[-*+][ \t]+`)
+	mdLink       = regexp.MustCompile(`\[([^\]\n]+)\]\(([^)\s]+)\)`)
+	mdBold       = regexp.MustCompile(`\*\*([^*\n]+?)\*\*|__([^_\n]+?)__`)
+	mdStrike     = regexp.MustCompile(`~~([^~\n]+?)~~`)
+	mdItalic     = regexp.MustCompile(`\*([^*\s][^*\n]*?)\*`)
+	mdLinkMarker = regexp.MustCompile("\x01([0-9]+)\x01")
+)
+
+// ToMrkdwn converts common Markdown to Slack mrkdwn on a best-effort basis:
+// bold, italic, strikethrough, links, headings (rendered bold) and bullet
+// lists. Inline code and fenced code blocks are left untouched. Anything else,
+// including tables, images and nested formatting, passes through as is.
+func ToMrkdwn(markdown string) string {
+	// Odd segments are inside ``` fences.
+	segments := strings.Split(markdown, "```")
+	for i := 0; i < len(segments); i += 2 {
+		segments[i] = convertOutsideInlineCode(segments[i])
+	}
+	return strings.Join(segments, "```")
+}
+
+func convertOutsideInlineCode(text string) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range mdInlineCode.FindAllStringIndex(text, -1) {
+		b.WriteString(convertMarkdownSpan(text[last:loc[0]]))
+		b.WriteString(text[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	b.WriteString(convertMarkdownSpan(text[last:]))
+	return b.String()
+}
+
+func convertMarkdownSpan(text string) string {
+	if text == "" {
+		return text
+	}
+
+	// Links are swapped for placeholders so URLs are not reformatted.
+	var links []string
+	text = mdLink.ReplaceAllStringFunc(text, func(match string) string {
+		parts := mdLink.FindStringSubmatch(match)
+		links = append(links, "<"+parts[2]+"|"+parts[1]+">")
+		return "\x01" + strconv.Itoa(len(links)-1) + "\x01"
+	})
+
+	// Bold uses \x00 until single-asterisk italics are converted.
+	text = mdHeading.ReplaceAllString(text, "\x00$1\x00")
+	text = mdBullet.ReplaceAllString(text, "$1• ")
+	text = mdBold.ReplaceAllString(text, "\x00$1$2\x00")
+	text = mdStrike.ReplaceAllString(text, "~$1~")
+	text = mdItalic.ReplaceAllString(text, "_${1}_")
+	text = strings.ReplaceAll(text, "\x00", "*")
+
+	return mdLinkMarker.ReplaceAllStringFunc(text, func(match string) string {
+		index, err := strconv.Atoi(strings.Trim(match, "\x01"))
+		if err != nil || index >= len(links) {
+			return match
+		}
+		return links[index]
+	})
+}
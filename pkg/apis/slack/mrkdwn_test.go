@@ -0,0 +1,33 @@
+package slack
+
+import "testing"
+
+func TestToMrkdwn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "link", in: "See [runbook](https://example.com/a_b*c) now", want: "See <https://example.com/a_b*c|runbook> now"},
+		{name: "bold", in: "**DB** and __cache__ are down", want: "*DB* and *cache* are down"},
+		{name: "italic", in: "this is *urgent*", want: "this is _urgent_"},
+		{name: "bold and italic", in: "**sev1** *maybe*", want: "*sev1* _maybe_"},
+		{name: "strike", in: "~~resolved~~", want: "~resolved~"},
+		{name: "heading", in: "## Impact\ntext", want: "*Impact*\ntext"},
+		{name: "bullets", in: "- one\n* two\n  + three", want: "• one\n• two\n  • three"},
+		{name: "inline code", in: "run `**not bold**` then **bold**", want: "run `**not bold**` then *bold*"},
+		{name: "code block", in: "```\n**raw** [x](y)\n```\n**after**", want: "```\n**raw** [x](y)\n```\n*after*"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := ToMrkdwn(tc.in); got != tc.want {
+				t.Fatalf("ToMrkdwn(%q)\n got: %q\nwant: %q", tc.in, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// RemindersService provides Slack reminders operations.
+type RemindersService struct {
+	client *Client
+}
+
+// AddReminder creates a reminder using reminders.add.
+// timeSpec accepts Unix timestamp, seconds from now, or natural language
+// such as "in 15 minutes" or "every Thursday at 9am". Empty userID creates
+// the reminder for the token owner.
+func (s *RemindersService) AddReminder(ctx context.Context, text string, timeSpec string, userID string) (*Reminder, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("slack: reminder text is required")
+	}
+	if strings.TrimSpace(timeSpec) == "" {
+		return nil, errors.New("slack: reminder time is required")
+	}
+
+	form := url.Values{}
+	form.Set("text", text)
+	form.Set("time", timeSpec)
+	if trimmed := strings.TrimSpace(userID); trimmed != "" {
+		form.Set("user", trimmed)
+	}
+	s.client.withTeamID(form)
+
+	req, err := s.client.newFormRequest(ctx, "reminders.add", form)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Reminder Reminder `json:"reminder"`
+	}
+	if err := s.client.do(req, &response); err != nil {
+		return nil, err
+	}
+	return &response.Reminder, nil
+}
+
+// ListReminders lists reminders created by or for the token owner using reminders.list.
+func (s *RemindersService) ListReminders(ctx context.Context) ([]Reminder, error) {
+	params := url.Values{}
+	s.client.withTeamID(params)
+
+	req, err := s.client.newGetRequest(ctx, "reminders.list", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Reminders []Reminder `json:"reminders"`
+	}
+	if err := s.client.do(req, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Reminders) == 0 {
+		return []Reminder{}, nil
+	}
+	return response.Reminders, nil
+}
@@ -0,0 +1,108 @@
+package slack
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
+)
+
+func TestAddReminder(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/reminders.add" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if r.Method != http.MethodPost {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			t.Fatalf("parse form: %v", err)
+		}
+		if form.Get("text") != "Post incident update" {
+			t.Fatalf("unexpected text: %q", form.Get("text"))
+		}
+		if form.Get("time") != "in 30 minutes" {
+			t.Fatalf("unexpected time: %q", form.Get("time"))
+		}
+		if form.Get("user") != "U123" {
+			t.Fatalf("unexpected user: %q", form.Get("user"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"reminder":{"id":"Rm123","creator":"U999","user":"U123","text":"Post incident update","recurring":false,"time":1700000000,"complete_ts":0}}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxp-test"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	reminder, err := client.Reminders().AddReminder(context.Background(), "Post incident update", "in 30 minutes", "U123")
+	if err != nil {
+		t.Fatalf("AddReminder failed: %v", err)
+	}
+	if reminder.ID != "Rm123" || reminder.Creator != "U999" || reminder.Time != 1700000000 || reminder.Complete() {
+		t.Fatalf("unexpected reminder: %+v", reminder)
+	}
+}
+
+func TestAddReminderValidation(t *testing.T) {
+	t.Parallel()
+
+	client, err := NewClient(WithToken("xoxp-test"))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	if _, err := client.Reminders().AddReminder(context.Background(), " ", "tomorrow", ""); err == nil {
+		t.Fatalf("expected error for empty text")
+	}
+	if _, err := client.Reminders().AddReminder(context.Background(), "text", "", ""); err == nil {
+		t.Fatalf("expected error for empty time")
+	}
+}
+
+func TestListReminders(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/reminders.list" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if r.Method != http.MethodGet {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"reminders":[{"id":"Rm1","creator":"U1","user":"U1","text":"standup","recurring":true},{"id":"Rm2","creator":"U1","user":"U2","text":"retro","time":1700000000,"complete_ts":1700000100}]}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxp-test"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	reminders, err := client.Reminders().ListReminders(context.Background())
+	if err != nil {
+		t.Fatalf("ListReminders failed: %v", err)
+	}
+	if len(reminders) != 2 {
+		t.Fatalf("expected 2 reminders, got %d", len(reminders))
+	}
+	if !reminders[0].Recurring || reminders[0].Complete() {
+		t.Fatalf("unexpected first reminder: %+v", reminders[0])
+	}
+	if !reminders[1].Complete() || reminders[1].User != "U2" {
+		t.Fatalf("unexpected second reminder: %+v", reminders[1])
+	}
+}
@@ -2,19 +2,29 @@ package slack
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"math/rand"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
 )
 
-const defaultSocketModeReconnectDelay = time.Second
+const (
+	defaultSocketModeReconnectDelay    = time.Second
+	defaultSocketModeMaxReconnectDelay = 30 * time.Second
+	// socketModeStableConnection is how long a connection must stay up for
+	// the reconnect backoff to start over from reconnectDelay.
+	socketModeStableConnection = time.Minute
+)
 
 // SocketModeEvent is a single event envelope delivered over socket mode.
 type SocketModeEvent struct {
@@ -24,7 +34,12 @@ type SocketModeEvent struct {
 	Payload                json.RawMessage `json:"payload,omitempty"`
 	RetryAttempt           int             `json:"retry_attempt,omitempty"`
 	RetryReason            string          `json:"retry_reason,omitempty"`
-	Reason                 string          `json:"reason,omitempty"`
+	// Reason explains disconnect envelopes, e.g. "refresh_requested" or
+	// "warning" before Slack rotates the connection.
+	Reason string `json:"reason,omitempty"`
+	// DebugInfo carries Slack's debugging context (host, connection age)
+	// from hello and disconnect envelopes.
+	DebugInfo map[string]any `json:"debug_info,omitempty"`
 }
 
 // SocketModeResponse contains optional payload sent in envelope ACK.
@@ -32,6 +47,86 @@ type SocketModeResponse struct {
 	Payload any `json:"payload,omitempty"`
 }
 
+// Slash command response types.
+const (
+	ResponseTypeInChannel = "in_channel"
+	ResponseTypeEphemeral = "ephemeral"
+)
+
+// NewTextResponse builds slash command ACK payload visible to the whole channel.
+func NewTextResponse(text string) *SocketModeResponse {
+	return &SocketModeResponse{Payload: map[string]any{
+		"response_type": ResponseTypeInChannel,
+		"text":          text,
+	}}
+}
+
+// NewEphemeralResponse builds slash command ACK payload visible only to the invoking user.
+func NewEphemeralResponse(text string) *SocketModeResponse {
+	return &SocketModeResponse{Payload: map[string]any{
+		"response_type": ResponseTypeEphemeral,
+		"text":          text,
+	}}
+}
+
+// NewBlocksResponse builds slash command ACK payload with Block Kit blocks visible to the channel.
+func NewBlocksResponse(blocks []map[string]any) *SocketModeResponse {
+	if blocks == nil {
+		blocks = []map[string]any{}
+	}
+	return &SocketModeResponse{Payload: map[string]any{
+		"response_type": ResponseTypeInChannel,
+		"blocks":        blocks,
+	}}
+}
+
+// View submission response actions.
+const (
+	ViewResponseActionErrors = "errors"
+	ViewResponseActionUpdate = "update"
+	ViewResponseActionPush   = "push"
+	ViewResponseActionClear  = "clear"
+)
+
+// NewViewErrorsResponse builds view_submission ACK payload that keeps the modal
+// open and shows validation errors keyed by input block_id.
+func NewViewErrorsResponse(errs map[string]string) *SocketModeResponse {
+	if errs == nil {
+		errs = map[string]string{}
+	}
+	return &SocketModeResponse{Payload: map[string]any{
+		"response_action": ViewResponseActionErrors,
+		"errors":          errs,
+	}}
+}
+
+// NewViewUpdateResponse builds view_submission ACK payload that replaces the current modal view.
+func NewViewUpdateResponse(view ModalViewRequest) *SocketModeResponse {
+	return newViewActionResponse(ViewResponseActionUpdate, view)
+}
+
+// NewViewPushResponse builds view_submission ACK payload that pushes a new view onto the modal stack.
+func NewViewPushResponse(view ModalViewRequest) *SocketModeResponse {
+	return newViewActionResponse(ViewResponseActionPush, view)
+}
+
+// NewViewClearResponse builds view_submission ACK payload that closes all views in the modal stack.
+func NewViewClearResponse() *SocketModeResponse {
+	return &SocketModeResponse{Payload: map[string]any{
+		"response_action": ViewResponseActionClear,
+	}}
+}
+
+func newViewActionResponse(action string, view ModalViewRequest) *SocketModeResponse {
+	if view.Type == "" {
+		view.Type = "modal"
+	}
+	return &SocketModeResponse{Payload: map[string]any{
+		"response_action": action,
+		"view":            view,
+	}}
+}
+
 // SocketModeHandler processes socket mode events.
 type SocketModeHandler interface {
 	HandleEvent(ctx context.Context, event SocketModeEvent) (*SocketModeResponse, error)
@@ -57,6 +152,18 @@ type SocketModeDialer interface {
 	Dial(ctx context.Context, wsURL string) (SocketModeConn, error)
 }
 
+// SocketModeObserver receives socket mode lifecycle notifications, e.g. for
+// readiness probes. Callbacks run synchronously on the Run goroutine.
+type SocketModeObserver interface {
+	OnConnected()
+	// OnDisconnected is called when a connection ends; err is nil when Slack
+	// asked the client to reconnect.
+	OnDisconnected(err error)
+	// OnEvent is called for every received envelope, including hello and
+	// disconnect.
+	OnEvent(eventType string)
+}
+
 // SocketModeOption configures SocketModeClient.
 type SocketModeOption func(*socketModeConfig)
 
@@ -66,7 +173,16 @@ type socketModeConfig struct {
 	transport      *transport.Client
 	dialer         SocketModeDialer
 	reconnectDelay time.Duration
+	maxReconnect   time.Duration
+	maxFailures    int
 	logger         transport.Logger
+	observer       SocketModeObserver
+	headers        http.Header
+	maxFrameSize   int
+	tlsConfig      *tls.Config
+	dialContext    func(ctx context.Context, network, addr string) (net.Conn, error)
+	readTimeout    time.Duration
+	ackTimeout     time.Duration
 }
 
 // SocketModeClient manages Slack socket mode lifecycle.
@@ -76,7 +192,16 @@ type SocketModeClient struct {
 	transport      *transport.Client
 	dialer         SocketModeDialer
 	reconnectDelay time.Duration
+	maxReconnect   time.Duration
+	maxFailures    int
 	logger         transport.Logger
+	observer       SocketModeObserver
+	ackTimeout     time.Duration
+
+	// sleep waits between reconnects; replaced in tests.
+	sleep  func(ctx context.Context, d time.Duration) error
+	randMu sync.Mutex
+	rand   *rand.Rand
 }
 
 // NewSocketModeClient creates a socket mode client.
@@ -84,6 +209,7 @@ func NewSocketModeClient(opts ...SocketModeOption) *SocketModeClient {
 	cfg := socketModeConfig{
 		baseURL:        defaultBaseURL,
 		reconnectDelay: defaultSocketModeReconnectDelay,
+		maxReconnect:   defaultSocketModeMaxReconnectDelay,
 	}
 	for _, opt := range opts {
 		if opt != nil {
@@ -94,7 +220,7 @@ func NewSocketModeClient(opts ...SocketModeOption) *SocketModeClient {
 		cfg.transport = transport.New()
 	}
 	if cfg.dialer == nil {
-		cfg.dialer = &rfc6455Dialer{}
+		cfg.dialer = &rfc6455Dialer{headers: cfg.headers, maxFrameSize: cfg.maxFrameSize, tlsConfig: cfg.tlsConfig, dialContext: cfg.dialContext, readTimeout: cfg.readTimeout}
 	}
 	parsedBaseURL, err := url.Parse(cfg.baseURL)
 	if err != nil || parsedBaseURL.Scheme == "" || parsedBaseURL.Host == "" {
@@ -103,6 +229,9 @@ func NewSocketModeClient(opts ...SocketModeOption) *SocketModeClient {
 	if cfg.reconnectDelay < 0 {
 		cfg.reconnectDelay = defaultSocketModeReconnectDelay
 	}
+	if cfg.maxReconnect < cfg.reconnectDelay {
+		cfg.maxReconnect = cfg.reconnectDelay
+	}
 
 	return &SocketModeClient{
 		appToken:       strings.TrimSpace(cfg.appToken),
@@ -110,7 +239,13 @@ func NewSocketModeClient(opts ...SocketModeOption) *SocketModeClient {
 		transport:      cfg.transport,
 		dialer:         cfg.dialer,
 		reconnectDelay: cfg.reconnectDelay,
+		maxReconnect:   cfg.maxReconnect,
+		maxFailures:    cfg.maxFailures,
 		logger:         cfg.logger,
+		observer:       cfg.observer,
+		ackTimeout:     cfg.ackTimeout,
+		sleep:          sleepContext,
+		rand:           rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
 
@@ -146,13 +281,113 @@ func WithSocketModeDialer(dialer SocketModeDialer) SocketModeOption {
 	}
 }
 
-// WithSocketModeReconnectDelay sets reconnect delay after connection errors.
+// WithSocketModeHandshakeHeaders adds headers to the websocket upgrade request
+// (e.g. for authenticating proxies). A User-Agent here replaces the default.
+// Only applies to the built-in dialer.
+func WithSocketModeHandshakeHeaders(headers http.Header) SocketModeOption {
+	return func(cfg *socketModeConfig) {
+		if len(headers) == 0 {
+			return
+		}
+		if cfg.headers == nil {
+			cfg.headers = http.Header{}
+		}
+		for key, values := range headers {
+			for _, value := range values {
+				cfg.headers.Add(key, value)
+			}
+		}
+	}
+}
+
+// WithSocketModeMaxMessageSize limits websocket frame payload size for both
+// reads and writes (default 32 MB). Only applies to the built-in dialer.
+func WithSocketModeMaxMessageSize(size int) SocketModeOption {
+	return func(cfg *socketModeConfig) {
+		if size > 0 {
+			cfg.maxFrameSize = size
+		}
+	}
+}
+
+// WithSocketModeTLSConfig overrides TLS settings for wss connections (e.g. a
+// custom root CA pool). The config is cloned and ServerName defaults to the
+// websocket host. Only applies to the built-in dialer.
+func WithSocketModeTLSConfig(cfg *tls.Config) SocketModeOption {
+	return func(c *socketModeConfig) {
+		c.tlsConfig = cfg
+	}
+}
+
+// WithSocketModeNetDialer overrides how the TCP connection for the websocket
+// is opened, e.g. to tunnel through an HTTP CONNECT or SOCKS proxy. addr is the
+// resolved host:port. Only applies to the built-in dialer.
+func WithSocketModeNetDialer(dial func(ctx context.Context, network, addr string) (net.Conn, error)) SocketModeOption {
+	return func(cfg *socketModeConfig) {
+		cfg.dialContext = dial
+	}
+}
+
+// WithSocketModeReadTimeout bounds how long the connection may stay silent
+// (no frames, including pings) before the read fails and Run reconnects.
+// Zero (default) waits indefinitely. Only applies to the built-in dialer.
+func WithSocketModeReadTimeout(timeout time.Duration) SocketModeOption {
+	return func(cfg *socketModeConfig) {
+		if timeout >= 0 {
+			cfg.readTimeout = timeout
+		}
+	}
+}
+
+// WithSocketModeAckTimeout bounds how long an envelope waits for its handler
+// before being acknowledged. Slack redelivers envelopes not acknowledged
+// within 3 seconds; when the handler runs longer than timeout the envelope is
+// acknowledged without payload and the handler keeps running in the
+// background, so handlers may then run concurrently. Zero (default) always
+// waits for the handler.
+func WithSocketModeAckTimeout(timeout time.Duration) SocketModeOption {
+	return func(cfg *socketModeConfig) {
+		if timeout >= 0 {
+			cfg.ackTimeout = timeout
+		}
+	}
+}
+
+// WithSocketModeReconnectDelay sets the initial reconnect delay. Consecutive
+// failures double it (with jitter) up to WithSocketModeMaxReconnectDelay.
 func WithSocketModeReconnectDelay(delay time.Duration) SocketModeOption {
 	return func(cfg *socketModeConfig) {
 		cfg.reconnectDelay = delay
 	}
 }
 
+// WithSocketModeMaxReconnectDelay caps reconnect backoff (default 30s).
+func WithSocketModeMaxReconnectDelay(delay time.Duration) SocketModeOption {
+	return func(cfg *socketModeConfig) {
+		if delay > 0 {
+			cfg.maxReconnect = delay
+		}
+	}
+}
+
+// WithSocketModeMaxReconnects makes Run give up and return the last error
+// after n consecutive dial or connection failures. Zero (default) retries
+// until the context is cancelled.
+func WithSocketModeMaxReconnects(n int) SocketModeOption {
+	return func(cfg *socketModeConfig) {
+		if n >= 0 {
+			cfg.maxFailures = n
+		}
+	}
+}
+
+// WithSocketModeObserver registers lifecycle callbacks.
+func WithSocketModeObserver(observer SocketModeObserver) SocketModeOption {
+	return func(cfg *socketModeConfig) {
+		cfg.observer = observer
+	}
+}
+
 // WithSocketModeLogger sets optional logger for socket mode runtime diagnostics.
 func WithSocketModeLogger(logger transport.Logger) SocketModeOption {
 	return func(cfg *socketModeConfig) {
@@ -183,6 +418,7 @@ func (c *SocketModeClient) RunWithHandler(ctx context.Context, handler SocketMod
 		return errors.New("slack: socket mode dialer is not configured")
 	}
 
+	failures := 0
 	for {
 		if err := ctx.Err(); err != nil {
 			return err
@@ -198,17 +434,29 @@ func (c *SocketModeClient) RunWithHandler(ctx context.Context, handler SocketMod
 			if c.logger != nil {
 				c.logger.Printf("slack socket mode: dial failed: %v", err)
 			}
-			if waitErr := c.waitReconnect(ctx); waitErr != nil {
+			failures++
+			if c.maxFailures > 0 && failures >= c.maxFailures {
+				return fmt.Errorf("slack: socket mode gave up after %d consecutive failures: %w", failures, err)
+			}
+			if waitErr := c.waitReconnect(ctx, failures); waitErr != nil {
 				return waitErr
 			}
 			continue
 		}
 
+		connected := time.Now()
+		if c.observer != nil {
+			c.observer.OnConnected()
+		}
 		err = c.processConnection(ctx, conn, handler)
 		_ = conn.Close()
+		if c.observer != nil {
+			c.observer.OnDisconnected(err)
+		}
 
 		if err == nil {
-			if waitErr := c.waitReconnect(ctx); waitErr != nil {
+			failures = 0
+			if waitErr := c.waitReconnect(ctx, 1); waitErr != nil {
 				return waitErr
 			}
 			continue
@@ -223,7 +471,14 @@ func (c *SocketModeClient) RunWithHandler(ctx context.Context, handler SocketMod
 		if c.logger != nil {
 			c.logger.Printf("slack socket mode: connection ended: %v", err)
 		}
-		if waitErr := c.waitReconnect(ctx); waitErr != nil {
+		if time.Since(connected) >= socketModeStableConnection {
+			failures = 0
+		}
+		failures++
+		if c.maxFailures > 0 && failures >= c.maxFailures {
+			return fmt.Errorf("slack: socket mode gave up after %d consecutive failures: %w", failures, err)
+		}
+		if waitErr := c.waitReconnect(ctx, failures); waitErr != nil {
 			return waitErr
 		}
 	}
@@ -246,25 +501,21 @@ func (c *SocketModeClient) processConnection(ctx context.Context, conn SocketMod
 		if err := conn.ReadJSON(&event); err != nil {
 			return err
 		}
+		if c.observer != nil {
+			c.observer.OnEvent(event.Type)
+		}
 
 		// Handle disconnect: Slack asks us to reconnect.
 		if event.Type == "disconnect" {
 			if c.logger != nil {
-				c.logger.Printf("slack socket mode: disconnect received: reason=%s", event.Reason)
+				c.logger.Printf("slack socket mode: disconnect received: reason=%s debug_info=%v", event.Reason, event.DebugInfo)
 			}
 			return nil // returning nil triggers reconnect in RunWithHandler loop
 		}
 
 		var response *SocketModeResponse
 		if handler != nil {
-			handlerResponse, err := handler.HandleEvent(ctx, event)
-			if err != nil {
-				if c.logger != nil {
-					c.logger.Printf("slack socket mode: handler error: %v", err)
-				}
-			} else {
-				response = handlerResponse
-			}
+			response = c.handleEvent(ctx, handler, event)
 		}
 
 		if strings.TrimSpace(event.EnvelopeID) == "" {
@@ -284,8 +535,79 @@ func (c *SocketModeClient) processConnection(ctx context.Context, conn SocketMod
 	}
 }
 
-func (c *SocketModeClient) waitReconnect(ctx context.Context) error {
+// handleEvent runs handler for event and returns the ACK payload. With an ack
+// timeout, a handler still running when it elapses is left in the background
+// and nil is returned so the envelope is acknowledged right away.
+func (c *SocketModeClient) handleEvent(ctx context.Context, handler SocketModeHandler, event SocketModeEvent) *SocketModeResponse {
+	if c.ackTimeout <= 0 || strings.TrimSpace(event.EnvelopeID) == "" {
+		return c.callHandler(ctx, handler, event)
+	}
+
+	done := make(chan *SocketModeResponse, 1)
+	go func() {
+		done <- c.callHandler(ctx, handler, event)
+	}()
+
+	timer := time.NewTimer(c.ackTimeout)
+	defer timer.Stop()
+	select {
+	case response := <-done:
+		return response
+	case <-timer.C:
+		if c.logger != nil {
+			c.logger.Printf("slack socket mode: handler exceeded %s, acknowledging envelope %s without payload", c.ackTimeout, event.EnvelopeID)
+		}
+		return nil
+	}
+}
+
+func (c *SocketModeClient) callHandler(ctx context.Context, handler SocketModeHandler, event SocketModeEvent) *SocketModeResponse {
+	response, err := handler.HandleEvent(ctx, event)
+	if err != nil {
+		if c.logger != nil {
+			c.logger.Printf("slack socket mode: handler error: %v", err)
+		}
+		return nil
+	}
+	return response
+}
+
+func (c *SocketModeClient) waitReconnect(ctx context.Context, failures int) error {
+	sleep := c.sleep
+	if sleep == nil {
+		sleep = sleepContext
+	}
+	return sleep(ctx, c.reconnectBackoff(failures))
+}
+
+// reconnectBackoff returns the delay before reconnect attempt after the given
+// number of consecutive failures: reconnectDelay doubled per failure, capped
+// at maxReconnect, with equal jitter so clients do not reconnect in lockstep.
+func (c *SocketModeClient) reconnectBackoff(failures int) time.Duration {
 	if c.reconnectDelay <= 0 {
+		return 0
+	}
+
+	delay := c.reconnectDelay
+	for i := 1; i < failures && delay < c.maxReconnect; i++ {
+		delay *= 2
+	}
+	if c.maxReconnect > 0 && delay > c.maxReconnect {
+		delay = c.maxReconnect
+	}
+	if c.rand == nil {
+		return delay
+	}
+
+	half := delay / 2
+	c.randMu.Lock()
+	jitter := time.Duration(c.rand.Int63n(int64(delay-half) + 1))
+	c.randMu.Unlock()
+	return half + jitter
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
@@ -294,7 +616,7 @@ func (c *SocketModeClient) waitReconnect(ctx context.Context) error {
 		}
 	}
 
-	timer := time.NewTimer(c.reconnectDelay)
+	timer := time.NewTimer(d)
 	defer timer.Stop()
 	select {
 	case <-ctx.Done():
@@ -125,6 +125,64 @@ func TestSocketModeRunWithHandlerErrorStillAcks(t *testing.T) {
 	}
 }
 
+func TestSocketModeAckTimeoutAcksBeforeSlowHandler(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"url":"ws://socket.example/connection-slow"}`))
+	}))
+	defer srv.Close()
+
+	conn := &fakeSocketModeConn{
+		readMessages: []string{
+			`{"type":"interactive","envelope_id":"env-slow","accepts_response_payload":true,"payload":{"type":"block_actions"}}`,
+		},
+	}
+	dialer := &fakeSocketModeDialer{conns: []SocketModeConn{conn}}
+
+	client := NewSocketModeClient(
+		WithAppLevelToken("xapp-test"),
+		WithSocketModeBaseURL(srv.URL),
+		WithSocketModeTransport(transport.New()),
+		WithSocketModeDialer(dialer),
+		WithSocketModeReconnectDelay(0),
+		WithSocketModeMaxReconnects(1),
+		WithSocketModeAckTimeout(20*time.Millisecond),
+	)
+
+	release := make(chan struct{})
+	handlerDone := make(chan struct{})
+	err := client.RunWithHandler(context.Background(), SocketModeHandlerFunc(func(ctx context.Context, event SocketModeEvent) (*SocketModeResponse, error) {
+		defer close(handlerDone)
+		<-release
+		return NewTextResponse("too late"), nil
+	}))
+	if err == nil {
+		t.Fatalf("expected Run to give up after the fake connection closed")
+	}
+
+	select {
+	case <-handlerDone:
+		t.Fatalf("handler completed before ACK was checked")
+	default:
+	}
+	writes := conn.writesSnapshot()
+	if len(writes) != 1 || writes[0]["envelope_id"] != "env-slow" {
+		t.Fatalf("expected one ACK for env-slow, got %+v", writes)
+	}
+	if _, hasPayload := writes[0]["payload"]; hasPayload {
+		t.Fatalf("expected ACK without payload after timeout: %+v", writes[0])
+	}
+
+	close(release)
+	select {
+	case <-handlerDone:
+	case <-time.After(time.Second):
+		t.Fatalf("handler did not keep running in the background")
+	}
+}
+
 func TestSocketModeRunWithHandlerHelloNoAck(t *testing.T) {
 	t.Parallel()
 
@@ -209,6 +267,156 @@ func TestSocketModeRunReconnectsAfterDialError(t *testing.T) {
 	}
 }
 
+func TestSocketModeReconnectBackoffGrows(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"url":"ws://socket.example/conn"}`))
+	}))
+	defer srv.Close()
+
+	failures := make([]error, 10)
+	for i := range failures {
+		failures[i] = errors.New("dial failure")
+	}
+	client := NewSocketModeClient(
+		WithAppLevelToken("xapp-test"),
+		WithSocketModeBaseURL(srv.URL),
+		WithSocketModeTransport(transport.New()),
+		WithSocketModeDialer(&fakeSocketModeDialer{errs: failures}),
+		WithSocketModeReconnectDelay(100*time.Millisecond),
+		WithSocketModeMaxReconnectDelay(time.Second),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var delays []time.Duration
+	client.sleep = func(ctx context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		if len(delays) == 6 {
+			cancel()
+			return ctx.Err()
+		}
+		return nil
+	}
+
+	if err := client.Run(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if len(delays) != 6 {
+		t.Fatalf("expected 6 reconnect waits, got %d", len(delays))
+	}
+	for i, d := range delays {
+		if d < 50*time.Millisecond || d > time.Second {
+			t.Fatalf("delay %d out of range: %v", i, d)
+		}
+		// Before the cap, each jitter window starts where the previous ends.
+		if i > 0 && i < 4 && d < delays[i-1] {
+			t.Fatalf("expected non-decreasing delays, got %v", delays)
+		}
+	}
+	if delays[3] <= delays[0] {
+		t.Fatalf("expected delays to grow, got %v", delays)
+	}
+	if delays[5] < 500*time.Millisecond {
+		t.Fatalf("expected capped delay near max, got %v", delays[5])
+	}
+}
+
+func TestSocketModeMaxReconnectsGivesUp(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"url":"ws://socket.example/conn"}`))
+	}))
+	defer srv.Close()
+
+	lastErr := errors.New("dial failure 2")
+	dialer := &fakeSocketModeDialer{errs: []error{errors.New("dial failure 1"), lastErr, errors.New("dial failure 3")}}
+	client := NewSocketModeClient(
+		WithAppLevelToken("xapp-test"),
+		WithSocketModeBaseURL(srv.URL),
+		WithSocketModeTransport(transport.New()),
+		WithSocketModeDialer(dialer),
+		WithSocketModeReconnectDelay(0),
+		WithSocketModeMaxReconnects(2),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	err := client.Run(ctx)
+	if !errors.Is(err, lastErr) {
+		t.Fatalf("expected last dial error, got %v", err)
+	}
+	if len(dialer.wsURLs) != 2 {
+		t.Fatalf("expected 2 dial attempts, got %d", len(dialer.wsURLs))
+	}
+}
+
+type recordingSocketModeObserver struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (o *recordingSocketModeObserver) record(event string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.events = append(o.events, event)
+}
+
+func (o *recordingSocketModeObserver) OnConnected() { o.record("connected") }
+
+func (o *recordingSocketModeObserver) OnDisconnected(err error) {
+	if err == nil {
+		o.record("disconnected")
+		return
+	}
+	o.record("disconnected: " + err.Error())
+}
+
+func (o *recordingSocketModeObserver) OnEvent(eventType string) { o.record("event " + eventType) }
+
+func TestSocketModeObserverOrdering(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"url":"ws://socket.example/conn"}`))
+	}))
+	defer srv.Close()
+
+	conn := &fakeSocketModeConn{
+		readMessages: []string{
+			`{"type":"hello"}`,
+			`{"type":"events_api","envelope_id":"env-1","payload":{}}`,
+		},
+	}
+	observer := &recordingSocketModeObserver{}
+	client := NewSocketModeClient(
+		WithAppLevelToken("xapp-test"),
+		WithSocketModeBaseURL(srv.URL),
+		WithSocketModeTransport(transport.New()),
+		WithSocketModeDialer(&fakeSocketModeDialer{conns: []SocketModeConn{conn}}),
+		WithSocketModeReconnectDelay(0),
+		WithSocketModeMaxReconnects(1),
+		WithSocketModeObserver(observer),
+	)
+
+	if err := client.Run(context.Background()); !errors.Is(err, io.EOF) {
+		t.Fatalf("expected io.EOF after giving up, got %v", err)
+	}
+
+	want := []string{"connected", "event hello", "event events_api", "disconnected: EOF"}
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+	if strings.Join(observer.events, "|") != strings.Join(want, "|") {
+		t.Fatalf("unexpected observer events: %v", observer.events)
+	}
+}
+
 func TestSocketModeDisconnectTriggersReconnect(t *testing.T) {
 	t.Parallel()
 
@@ -262,6 +470,55 @@ func TestSocketModeDisconnectTriggersReconnect(t *testing.T) {
 	}
 }
 
+func TestSocketModeDecodesDebugInfo(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"url":"ws://socket.example/conn"}`))
+	}))
+	defer srv.Close()
+
+	conn := &fakeSocketModeConn{
+		readMessages: []string{
+			`{"type":"hello","num_connections":1,"debug_info":{"host":"applink-7fc4fdbb64-4x5xq","build_number":10,"approximate_connection_time":18060}}`,
+		},
+	}
+	client := NewSocketModeClient(
+		WithAppLevelToken("xapp-test"),
+		WithSocketModeBaseURL(srv.URL),
+		WithSocketModeTransport(transport.New()),
+		WithSocketModeDialer(&fakeSocketModeDialer{conns: []SocketModeConn{conn}}),
+		WithSocketModeReconnectDelay(0),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var got SocketModeEvent
+	err := client.RunWithHandler(ctx, SocketModeHandlerFunc(func(ctx context.Context, event SocketModeEvent) (*SocketModeResponse, error) {
+		got = event
+		cancel()
+		return nil, nil
+	}))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	if got.Type != "hello" {
+		t.Fatalf("unexpected event: %+v", got)
+	}
+	if got.DebugInfo["host"] != "applink-7fc4fdbb64-4x5xq" || got.DebugInfo["approximate_connection_time"] != float64(18060) {
+		t.Fatalf("unexpected debug info: %v", got.DebugInfo)
+	}
+
+	var disconnect SocketModeEvent
+	if err := json.Unmarshal([]byte(`{"type":"disconnect","reason":"warning","debug_info":{"host":"applink-1"}}`), &disconnect); err != nil {
+		t.Fatalf("unmarshal disconnect: %v", err)
+	}
+	if disconnect.Reason != "warning" || disconnect.DebugInfo["host"] != "applink-1" {
+		t.Fatalf("unexpected disconnect envelope: %+v", disconnect)
+	}
+}
+
 func TestSocketModeRunValidationErrors(t *testing.T) {
 	t.Parallel()
 
@@ -310,6 +567,78 @@ func TestSocketModeOpenConnectionSlackError(t *testing.T) {
 	}
 }
 
+func TestSocketModeResponseHelpers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		response *SocketModeResponse
+		want     string
+	}{
+		{
+			name:     "text",
+			response: NewTextResponse("deployed"),
+			want:     `{"payload":{"response_type":"in_channel","text":"deployed"}}`,
+		},
+		{
+			name:     "ephemeral",
+			response: NewEphemeralResponse("only you can see this"),
+			want:     `{"payload":{"response_type":"ephemeral","text":"only you can see this"}}`,
+		},
+		{
+			name: "blocks",
+			response: NewBlocksResponse([]map[string]any{{
+				"type": "section",
+				"text": map[string]any{"type": "mrkdwn", "text": "*done*"},
+			}}),
+			want: `{"payload":{"blocks":[{"text":{"text":"*done*","type":"mrkdwn"},"type":"section"}],"response_type":"in_channel"}}`,
+		},
+		{
+			name:     "nil blocks",
+			response: NewBlocksResponse(nil),
+			want:     `{"payload":{"blocks":[],"response_type":"in_channel"}}`,
+		},
+		{
+			name:     "view errors",
+			response: NewViewErrorsResponse(map[string]string{"email_block": "Enter a valid email"}),
+			want:     `{"payload":{"errors":{"email_block":"Enter a valid email"},"response_action":"errors"}}`,
+		},
+		{
+			name: "view update",
+			response: NewViewUpdateResponse(ModalViewRequest{
+				CallbackID: "step-2",
+				Title:      &ViewText{Type: "plain_text", Text: "Step 2"},
+			}),
+			want: `{"payload":{"response_action":"update","view":{"type":"modal","callback_id":"step-2","title":{"type":"plain_text","text":"Step 2"}}}}`,
+		},
+		{
+			name:     "view push",
+			response: NewViewPushResponse(ModalViewRequest{Type: "modal", CallbackID: "details"}),
+			want:     `{"payload":{"response_action":"push","view":{"type":"modal","callback_id":"details"}}}`,
+		},
+		{
+			name:     "view clear",
+			response: NewViewClearResponse(),
+			want:     `{"payload":{"response_action":"clear"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := json.Marshal(tc.response)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(data) != tc.want {
+				t.Fatalf("unexpected payload:\n got: %s\nwant: %s", data, tc.want)
+			}
+		})
+	}
+}
+
 type fakeSocketModeDialer struct {
 	mu sync.Mutex
 
@@ -22,8 +22,11 @@ import (
 )
 
 const (
-	webSocketHandshakeTimeout = 10 * time.Second
-	maxWebSocketFrameSize     = 32 << 20 // 32 MB
+	webSocketHandshakeTimeout  = 10 * time.Second
+	webSocketCloseWriteTimeout = time.Second
+	maxWebSocketFrameSize      = 32 << 20 // 32 MB
+
+	wsCloseNormalClosure = 1000
 
 	wsOpcodeContinuation = 0x0
 	wsOpcodeText         = 0x1
@@ -33,7 +36,15 @@ const (
 	wsOpcodePong         = 0xA
 )
 
-type rfc6455Dialer struct{}
+const defaultSocketModeUserAgent = "suptech-go-kit/socket-mode"
+
+type rfc6455Dialer struct {
+	headers      http.Header
+	maxFrameSize int
+	tlsConfig    *tls.Config
+	dialContext  func(ctx context.Context, network, addr string) (net.Conn, error)
+	readTimeout  time.Duration
+}
 
 func (d *rfc6455Dialer) Dial(ctx context.Context, wsURL string) (SocketModeConn, error) {
 	endpoint, err := url.Parse(wsURL)
@@ -53,18 +64,18 @@ func (d *rfc6455Dialer) Dial(ctx context.Context, wsURL string) (SocketModeConn,
 		}
 	}
 
-	dialer := &net.Dialer{}
-	rawConn, err := dialer.DialContext(ctx, "tcp", hostPort)
+	dialContext := d.dialContext
+	if dialContext == nil {
+		dialContext = (&net.Dialer{}).DialContext
+	}
+	rawConn, err := dialContext(ctx, "tcp", hostPort)
 	if err != nil {
 		return nil, fmt.Errorf("slack: dial websocket host: %w", err)
 	}
 
 	conn := rawConn
 	if endpoint.Scheme == "wss" {
-		tlsConn := tls.Client(rawConn, &tls.Config{
-			ServerName: endpoint.Hostname(),
-			MinVersion: tls.VersionTLS12,
-		})
+		tlsConn := tls.Client(rawConn, d.clientTLSConfig(endpoint.Hostname()))
 		if err := tlsConn.HandshakeContext(ctx); err != nil {
 			_ = rawConn.Close()
 			return nil, fmt.Errorf("slack: tls handshake failed: %w", err)
@@ -72,15 +83,33 @@ func (d *rfc6455Dialer) Dial(ctx context.Context, wsURL string) (SocketModeConn,
 		conn = tlsConn
 	}
 
-	socketConn, err := websocketClientHandshake(ctx, conn, endpoint)
+	socketConn, err := websocketClientHandshake(ctx, conn, endpoint, d.headers)
 	if err != nil {
 		_ = conn.Close()
 		return nil, err
 	}
+	socketConn.maxFrameSize = d.maxFrameSize
+	socketConn.readTimeout = d.readTimeout
 	return socketConn, nil
 }
 
-func websocketClientHandshake(ctx context.Context, conn net.Conn, endpoint *url.URL) (*websocketConn, error) {
+// clientTLSConfig returns a copy of the configured TLS settings with
+// ServerName defaulted to host, or a TLS 1.2+ default config.
+func (d *rfc6455Dialer) clientTLSConfig(host string) *tls.Config {
+	if d.tlsConfig == nil {
+		return &tls.Config{
+			ServerName: host,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+	cfg := d.tlsConfig.Clone()
+	if cfg.ServerName == "" {
+		cfg.ServerName = host
+	}
+	return cfg
+}
+
+func websocketClientHandshake(ctx context.Context, conn net.Conn, endpoint *url.URL, headers http.Header) (*websocketConn, error) {
 	deadline := time.Now().Add(webSocketHandshakeTimeout)
 	if d, ok := ctx.Deadline(); ok {
 		deadline = d
@@ -100,14 +129,22 @@ func websocketClientHandshake(ctx context.Context, conn net.Conn, endpoint *url.
 	if requestURI == "" {
 		requestURI = "/"
 	}
-	request := fmt.Sprintf(
-		"GET %s HTTP/1.1\r\nHost: %s\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Key: %s\r\nSec-WebSocket-Version: 13\r\nUser-Agent: suptech-go-kit/socket-mode\r\n\r\n",
-		requestURI,
-		endpoint.Host,
-		secWebSocketKey,
-	)
+	var request strings.Builder
+	fmt.Fprintf(&request, "GET %s HTTP/1.1\r\n", requestURI)
+	fmt.Fprintf(&request, "Host: %s\r\n", endpoint.Host)
+	request.WriteString("Upgrade: websocket\r\nConnection: Upgrade\r\n")
+	fmt.Fprintf(&request, "Sec-WebSocket-Key: %s\r\n", secWebSocketKey)
+	request.WriteString("Sec-WebSocket-Version: 13\r\n")
+	extra := handshakeHeaders(headers)
+	if extra.Get("User-Agent") == "" {
+		extra.Set("User-Agent", defaultSocketModeUserAgent)
+	}
+	if err := extra.Write(&request); err != nil {
+		return nil, fmt.Errorf("slack: write websocket handshake headers: %w", err)
+	}
+	request.WriteString("\r\n")
 
-	if _, err := io.WriteString(conn, request); err != nil {
+	if _, err := io.WriteString(conn, request.String()); err != nil {
 		return nil, fmt.Errorf("slack: send websocket handshake: %w", err)
 	}
 
@@ -131,6 +168,22 @@ func websocketClientHandshake(ctx context.Context, conn net.Conn, endpoint *url.
 	}, nil
 }
 
+// handshakeHeaders copies caller headers, dropping those owned by the
+// websocket upgrade itself.
+func handshakeHeaders(headers http.Header) http.Header {
+	result := http.Header{}
+	for key, values := range headers {
+		switch http.CanonicalHeaderKey(key) {
+		case "Host", "Upgrade", "Connection", "Sec-Websocket-Key", "Sec-Websocket-Version", "Sec-Websocket-Accept":
+			continue
+		}
+		for _, value := range values {
+			result.Add(key, value)
+		}
+	}
+	return result
+}
+
 func wsAcceptKey(secWebSocketKey string) string {
 	hash := sha1.Sum([]byte(secWebSocketKey + "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"))
 	return base64.StdEncoding.EncodeToString(hash[:])
@@ -139,6 +192,12 @@ func wsAcceptKey(secWebSocketKey string) string {
 type websocketConn struct {
 	conn   net.Conn
 	reader *bufio.Reader
+	// maxFrameSize limits incoming and outgoing frame payloads.
+	// If <=0, maxWebSocketFrameSize is used.
+	maxFrameSize int
+	// readTimeout bounds how long a read may wait for the next frame.
+	// If <=0, reads block until a frame arrives or the conn is closed.
+	readTimeout time.Duration
 
 	writeMu sync.Mutex
 }
@@ -171,9 +230,23 @@ func (c *websocketConn) Close() error {
 	}
 	conn := c.conn
 	c.conn = nil
+
+	// Best-effort close handshake; the peer may already be gone.
+	var status [2]byte
+	binary.BigEndian.PutUint16(status[:], wsCloseNormalClosure)
+	if frame, err := buildClientFrame(wsOpcodeClose, status[:]); err == nil {
+		_ = conn.SetWriteDeadline(time.Now().Add(webSocketCloseWriteTimeout))
+		_, _ = conn.Write(frame)
+	}
 	return conn.Close()
 }
 
+func (c *websocketConn) currentConn() net.Conn {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return c.conn
+}
+
 func (c *websocketConn) readMessage() ([]byte, error) {
 	var (
 		buffer             bytes.Buffer
@@ -181,8 +254,23 @@ func (c *websocketConn) readMessage() ([]byte, error) {
 	)
 
 	for {
+		// Close may run concurrently (e.g. on context cancel) and clears
+		// c.conn under writeMu, so take the conn under the same lock.
+		conn := c.currentConn()
+		if conn == nil {
+			return nil, net.ErrClosed
+		}
+		if c.readTimeout > 0 {
+			if err := conn.SetReadDeadline(time.Now().Add(c.readTimeout)); err != nil {
+				return nil, fmt.Errorf("slack: set websocket read deadline: %w", err)
+			}
+		}
 		opcode, fin, payload, err := c.readFrame()
 		if err != nil {
+			var netErr net.Error
+			if c.readTimeout > 0 && errors.As(err, &netErr) && netErr.Timeout() {
+				return nil, fmt.Errorf("slack: websocket read timed out after %s: %w", c.readTimeout, err)
+			}
 			return nil, err
 		}
 
@@ -232,7 +320,7 @@ func (c *websocketConn) readFrame() (byte, bool, []byte, error) {
 	if err != nil {
 		return 0, false, nil, err
 	}
-	if payloadLen > maxWebSocketFrameSize {
+	if payloadLen > c.frameLimit() {
 		return 0, false, nil, fmt.Errorf("slack: websocket frame too large: %d", payloadLen)
 	}
 
@@ -281,7 +369,18 @@ func (c *websocketConn) readPayloadLength(base byte) (int, error) {
 	}
 }
 
+func (c *websocketConn) frameLimit() int {
+	if c.maxFrameSize > 0 {
+		return c.maxFrameSize
+	}
+	return maxWebSocketFrameSize
+}
+
 func (c *websocketConn) writeFrame(opcode byte, payload []byte) error {
+	if limit := c.frameLimit(); len(payload) > limit {
+		return fmt.Errorf("slack: websocket message too large: %d bytes exceeds limit of %d", len(payload), limit)
+	}
+
 	c.writeMu.Lock()
 	defer c.writeMu.Unlock()
 
@@ -0,0 +1,376 @@
+package slack
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// acceptHandshake plays the server side of the websocket upgrade on conn and
+// returns the received upgrade request.
+func acceptHandshake(t *testing.T, conn net.Conn) (*http.Request, *bufio.Reader) {
+	t.Helper()
+
+	reader := bufio.NewReader(conn)
+	req, err := http.ReadRequest(reader)
+	if err != nil {
+		t.Errorf("read upgrade request: %v", err)
+		return nil, nil
+	}
+	response := fmt.Sprintf(
+		"HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: %s\r\n\r\n",
+		wsAcceptKey(req.Header.Get("Sec-WebSocket-Key")),
+	)
+	if _, err := conn.Write([]byte(response)); err != nil {
+		t.Errorf("write upgrade response: %v", err)
+		return nil, nil
+	}
+	return req, reader
+}
+
+func TestWebsocketHandshakeForwardsHeaders(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		headers       http.Header
+		wantUserAgent string
+		wantProxyAuth string
+	}{
+		{
+			name:          "default user agent",
+			wantUserAgent: defaultSocketModeUserAgent,
+		},
+		{
+			name: "custom headers",
+			headers: http.Header{
+				"User-Agent":          []string{"incident-bot/1.0"},
+				"Proxy-Authorization": []string{"Basic abc"},
+				"Upgrade":             []string{"h2c"},
+			},
+			wantUserAgent: "incident-bot/1.0",
+			wantProxyAuth: "Basic abc",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			clientConn, serverConn := net.Pipe()
+			defer clientConn.Close()
+			defer serverConn.Close()
+
+			received := make(chan *http.Request, 1)
+			go func() {
+				req, _ := acceptHandshake(t, serverConn)
+				received <- req
+			}()
+
+			endpoint, _ := url.Parse("wss://wss-primary.slack.com/link/?ticket=abc")
+			conn, err := websocketClientHandshake(context.Background(), clientConn, endpoint, tc.headers)
+			if err != nil {
+				t.Fatalf("handshake failed: %v", err)
+			}
+			if conn == nil {
+				t.Fatalf("expected websocket connection")
+			}
+
+			req := <-received
+			if req == nil {
+				t.Fatalf("server did not receive upgrade request")
+			}
+			if got := req.Header.Get("User-Agent"); got != tc.wantUserAgent {
+				t.Fatalf("unexpected User-Agent: %q", got)
+			}
+			if got := req.Header.Get("Proxy-Authorization"); got != tc.wantProxyAuth {
+				t.Fatalf("unexpected Proxy-Authorization: %q", got)
+			}
+			if got := req.Header.Get("Upgrade"); got != "websocket" {
+				t.Fatalf("upgrade header must not be overridden, got %q", got)
+			}
+			if req.URL.RequestURI() != "/link/?ticket=abc" {
+				t.Fatalf("unexpected request URI: %s", req.URL.RequestURI())
+			}
+		})
+	}
+}
+
+func TestWithSocketModeHandshakeHeadersConfiguresDefaultDialer(t *testing.T) {
+	t.Parallel()
+
+	client := NewSocketModeClient(
+		WithAppLevelToken("xapp-test"),
+		WithSocketModeHandshakeHeaders(http.Header{"X-Proxy-Token": []string{"secret"}}),
+	)
+	dialer, ok := client.dialer.(*rfc6455Dialer)
+	if !ok {
+		t.Fatalf("expected built-in dialer, got %T", client.dialer)
+	}
+	if dialer.headers.Get("X-Proxy-Token") != "secret" {
+		t.Fatalf("expected headers to be passed to dialer, got %v", dialer.headers)
+	}
+}
+
+func TestWebsocketConnRejectsOversizedWrite(t *testing.T) {
+	t.Parallel()
+
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	conn := &websocketConn{conn: clientConn, reader: bufio.NewReader(clientConn), maxFrameSize: 16}
+
+	err := conn.WriteJSON(map[string]string{"envelope_id": "0123456789abcdef"})
+	if err == nil {
+		t.Fatalf("expected error for oversized payload")
+	}
+	if !strings.Contains(err.Error(), "too large") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestWithSocketModeMaxMessageSizeConfiguresDefaultDialer(t *testing.T) {
+	t.Parallel()
+
+	client := NewSocketModeClient(WithAppLevelToken("xapp-test"), WithSocketModeMaxMessageSize(1<<20))
+	dialer, ok := client.dialer.(*rfc6455Dialer)
+	if !ok {
+		t.Fatalf("expected built-in dialer, got %T", client.dialer)
+	}
+	if dialer.maxFrameSize != 1<<20 {
+		t.Fatalf("unexpected max frame size: %d", dialer.maxFrameSize)
+	}
+	if (&websocketConn{}).frameLimit() != maxWebSocketFrameSize {
+		t.Fatalf("expected default frame limit")
+	}
+}
+
+func TestWithSocketModeTLSConfigConfiguresDefaultDialer(t *testing.T) {
+	t.Parallel()
+
+	pool := x509.NewCertPool()
+	custom := &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS13}
+	client := NewSocketModeClient(WithAppLevelToken("xapp-test"), WithSocketModeTLSConfig(custom))
+	dialer, ok := client.dialer.(*rfc6455Dialer)
+	if !ok {
+		t.Fatalf("expected built-in dialer, got %T", client.dialer)
+	}
+
+	cfg := dialer.clientTLSConfig("wss-primary.slack.com")
+	if cfg == custom {
+		t.Fatalf("expected TLS config to be cloned")
+	}
+	if cfg.RootCAs != pool || cfg.MinVersion != tls.VersionTLS13 {
+		t.Fatalf("expected custom TLS settings, got %+v", cfg)
+	}
+	if cfg.ServerName != "wss-primary.slack.com" {
+		t.Fatalf("expected ServerName to default to host, got %q", cfg.ServerName)
+	}
+	if custom.ServerName != "" {
+		t.Fatalf("expected caller config to stay untouched, got %q", custom.ServerName)
+	}
+
+	custom.ServerName = "proxy.internal"
+	if got := dialer.clientTLSConfig("wss-primary.slack.com").ServerName; got != "proxy.internal" {
+		t.Fatalf("expected explicit ServerName to be kept, got %q", got)
+	}
+
+	defaults := (&rfc6455Dialer{}).clientTLSConfig("example.com")
+	if defaults.MinVersion != tls.VersionTLS12 || defaults.ServerName != "example.com" {
+		t.Fatalf("unexpected default TLS config: %+v", defaults)
+	}
+}
+
+func TestWithSocketModeNetDialerIsUsedByDefaultDialer(t *testing.T) {
+	t.Parallel()
+
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	go func() {
+		_, _ = acceptHandshake(t, serverConn)
+	}()
+
+	var gotNetwork, gotAddr string
+	client := NewSocketModeClient(
+		WithAppLevelToken("xapp-test"),
+		WithSocketModeNetDialer(func(ctx context.Context, network, addr string) (net.Conn, error) {
+			gotNetwork, gotAddr = network, addr
+			return clientConn, nil
+		}),
+	)
+
+	conn, err := client.dialer.Dial(context.Background(), "ws://wss-primary.slack.com/link/?ticket=abc")
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	if conn == nil {
+		t.Fatalf("expected websocket connection")
+	}
+	if gotNetwork != "tcp" || gotAddr != "wss-primary.slack.com:80" {
+		t.Fatalf("unexpected dial target: %s %s", gotNetwork, gotAddr)
+	}
+}
+
+type recordingConn struct {
+	net.Conn
+
+	writes        [][]byte
+	writeDeadline time.Time
+	closed        bool
+	writeAfter    bool
+}
+
+func (c *recordingConn) Write(p []byte) (int, error) {
+	if c.closed {
+		c.writeAfter = true
+		return 0, net.ErrClosed
+	}
+	c.writes = append(c.writes, append([]byte(nil), p...))
+	return len(p), nil
+}
+
+func (c *recordingConn) SetWriteDeadline(t time.Time) error {
+	c.writeDeadline = t
+	return nil
+}
+
+func (c *recordingConn) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestWebsocketConnCloseSendsCloseFrame(t *testing.T) {
+	t.Parallel()
+
+	raw := &recordingConn{}
+	conn := &websocketConn{conn: raw}
+
+	if err := conn.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if !raw.closed {
+		t.Fatalf("expected underlying connection to be closed")
+	}
+	if raw.writeAfter {
+		t.Fatalf("close frame written after underlying close")
+	}
+	if raw.writeDeadline.IsZero() {
+		t.Fatalf("expected write deadline to be set")
+	}
+	if len(raw.writes) != 1 {
+		t.Fatalf("expected single close frame, got %d writes", len(raw.writes))
+	}
+
+	frame := raw.writes[0]
+	if frame[0] != 0x80|wsOpcodeClose {
+		t.Fatalf("unexpected first byte: %#x", frame[0])
+	}
+	if frame[1] != 0x80|2 {
+		t.Fatalf("expected masked 2-byte payload, got %#x", frame[1])
+	}
+	mask := frame[2:6]
+	code := binary.BigEndian.Uint16([]byte{frame[6] ^ mask[0], frame[7] ^ mask[1]})
+	if code != wsCloseNormalClosure {
+		t.Fatalf("unexpected close code: %d", code)
+	}
+
+	if err := conn.Close(); err != nil {
+		t.Fatalf("second Close failed: %v", err)
+	}
+	if len(raw.writes) != 1 {
+		t.Fatalf("expected no writes on second Close, got %d", len(raw.writes))
+	}
+}
+
+func TestWebsocketConnReadTimeout(t *testing.T) {
+	t.Parallel()
+
+	// The server side never writes, so without a deadline ReadJSON would block forever.
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	conn := &websocketConn{conn: clientConn, reader: bufio.NewReader(clientConn), readTimeout: 50 * time.Millisecond}
+
+	done := make(chan error, 1)
+	go func() {
+		var v map[string]any
+		done <- conn.ReadJSON(&v)
+	}()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, os.ErrDeadlineExceeded) {
+			t.Fatalf("expected deadline exceeded error, got %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("ReadJSON did not return after read timeout")
+	}
+}
+
+func TestWebsocketConnReadTimeoutCloseDuringRead(t *testing.T) {
+	t.Parallel()
+
+	clientConn, serverConn := net.Pipe()
+	defer serverConn.Close()
+	go func() {
+		_, _ = io.Copy(io.Discard, serverConn)
+	}()
+
+	conn := &websocketConn{conn: clientConn, reader: bufio.NewReader(clientConn), readTimeout: time.Minute}
+	client := NewSocketModeClient(WithAppLevelToken("xapp-test"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- client.processConnection(ctx, conn, nil)
+	}()
+
+	// Let processConnection block in ReadJSON, then cancel so Close runs
+	// concurrently with the blocked read.
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatalf("expected read error after close")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("processConnection did not return after cancel")
+	}
+
+	var v map[string]any
+	if err := conn.ReadJSON(&v); !errors.Is(err, net.ErrClosed) {
+		t.Fatalf("expected net.ErrClosed after close, got %v", err)
+	}
+}
+
+func TestWithSocketModeReadTimeoutConfiguresDefaultDialer(t *testing.T) {
+	t.Parallel()
+
+	client := NewSocketModeClient(WithAppLevelToken("xapp-test"), WithSocketModeReadTimeout(90*time.Second))
+	dialer, ok := client.dialer.(*rfc6455Dialer)
+	if !ok {
+		t.Fatalf("expected built-in dialer, got %T", client.dialer)
+	}
+	if dialer.readTimeout != 90*time.Second {
+		t.Fatalf("unexpected read timeout: %s", dialer.readTimeout)
+	}
+}
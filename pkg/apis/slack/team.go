@@ -0,0 +1,59 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// TeamService provides Slack workspace (team) operations.
+type TeamService struct {
+	client *Client
+}
+
+// GetTeamInfo returns workspace info using team.info.
+// Empty teamID falls back to the client team ID, then to the token's workspace.
+func (s *TeamService) GetTeamInfo(ctx context.Context, teamID string) (*Team, error) {
+	params := url.Values{}
+	if trimmed := strings.TrimSpace(teamID); trimmed != "" {
+		params.Set("team", trimmed)
+	} else if s.client.teamID != "" {
+		params.Set("team", s.client.teamID)
+	}
+
+	req, err := s.client.newGetRequest(ctx, "team.info", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Team Team `json:"team"`
+	}
+	if err := s.client.do(req, &response); err != nil {
+		return nil, err
+	}
+	return &response.Team, nil
+}
+
+// ListEmoji returns custom workspace emoji using emoji.list.
+// Values are image URLs or "alias:<name>" for aliases.
+func (s *TeamService) ListEmoji(ctx context.Context) (map[string]string, error) {
+	params := url.Values{}
+	s.client.withTeamID(params)
+
+	req, err := s.client.newGetRequest(ctx, "emoji.list", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Emoji map[string]string `json:"emoji"`
+	}
+	if err := s.client.do(req, &response); err != nil {
+		return nil, err
+	}
+	if response.Emoji == nil {
+		return map[string]string{}, nil
+	}
+	return response.Emoji, nil
+}
@@ -0,0 +1,83 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SeniorPomidorro/suptech-go-kit/pkg/transport"
+)
+
+func TestGetTeamInfo(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/team.info" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if r.Method != http.MethodGet {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		if got := r.URL.Query().Get("team"); got != "T123" {
+			t.Fatalf("expected team=T123 from client config, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"team":{"id":"T123","name":"Acme","domain":"acme","email_domain":"acme.io","icon":{"image_68":"https://a.slack-edge.com/68.png","image_default":true}}}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTeamID("T123"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	team, err := client.Team().GetTeamInfo(context.Background(), "")
+	if err != nil {
+		t.Fatalf("GetTeamInfo failed: %v", err)
+	}
+	if team.ID != "T123" || team.Name != "Acme" || team.Domain != "acme" || team.EmailDomain != "acme.io" {
+		t.Fatalf("unexpected team: %+v", team)
+	}
+	if team.Icon.Image68 != "https://a.slack-edge.com/68.png" || !team.Icon.ImageDefault {
+		t.Fatalf("unexpected icon: %+v", team.Icon)
+	}
+}
+
+func TestListEmoji(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/emoji.list" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("team_id"); got != "T123" {
+			t.Fatalf("unexpected team_id: %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"emoji":{"partyparrot":"https://emoji.slack-edge.com/T123/partyparrot/abc.gif","parrot":"alias:partyparrot","ship_it":"alias:rocket"}}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTeamID("T123"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	emoji, err := client.Team().ListEmoji(context.Background())
+	if err != nil {
+		t.Fatalf("ListEmoji failed: %v", err)
+	}
+	if len(emoji) != 3 {
+		t.Fatalf("expected 3 emoji, got %d", len(emoji))
+	}
+	if emoji["parrot"] != "alias:partyparrot" {
+		t.Fatalf("unexpected alias entry: %q", emoji["parrot"])
+	}
+	if emoji["ship_it"] != "alias:rocket" {
+		t.Fatalf("unexpected unicode alias entry: %q", emoji["ship_it"])
+	}
+	if emoji["partyparrot"] != "https://emoji.slack-edge.com/T123/partyparrot/abc.gif" {
+		t.Fatalf("unexpected image entry: %q", emoji["partyparrot"])
+	}
+}
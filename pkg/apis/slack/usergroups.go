@@ -40,9 +40,25 @@ func (s *UserGroupsService) CreateUserGroup(ctx context.Context, name, tag strin
 	return &response.UserGroup, nil
 }
 
-// ListUserGroups lists user groups.
+// ListUserGroups lists enabled user groups without members.
 func (s *UserGroupsService) ListUserGroups(ctx context.Context) ([]UserGroup, error) {
+	return s.ListUserGroupsWithOptions(ctx, nil)
+}
+
+// ListUserGroupsWithOptions lists user groups using usergroups.list flags.
+func (s *UserGroupsService) ListUserGroupsWithOptions(ctx context.Context, opts *ListUserGroupsOptions) ([]UserGroup, error) {
 	params := url.Values{}
+	if opts != nil {
+		if opts.IncludeDisabled {
+			params.Set("include_disabled", "true")
+		}
+		if opts.IncludeCount {
+			params.Set("include_count", "true")
+		}
+		if opts.IncludeUsers {
+			params.Set("include_users", "true")
+		}
+	}
 	s.client.withTeamID(params)
 
 	req, err := s.client.newGetRequest(ctx, "usergroups.list", params)
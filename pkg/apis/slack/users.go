@@ -2,16 +2,21 @@ package slack
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 const defaultListUsersFetchAllThrottle = time.Second
 
+// maxStatusTextLength is the Slack limit for profile status_text in characters.
+const maxStatusTextLength = 100
+
 // UsersService provides Slack users operations.
 type UsersService struct {
 	client *Client
@@ -173,6 +178,90 @@ func (s *UsersService) ListUsers(ctx context.Context, req *ListUsersRequest) (*L
 	}
 }
 
+// ListUserConversations returns conversations the user is a member of via
+// users.conversations, following cursors until the last page. An empty
+// userID lists conversations of the token's own identity.
+func (s *UsersService) ListUserConversations(ctx context.Context, userID string, opts UserConversationsOptions) ([]Conversation, error) {
+	types, err := normalizeConversationTypes(opts.Types)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		cursor string
+		all    []Conversation
+	)
+	for {
+		params := url.Values{}
+		if strings.TrimSpace(userID) != "" {
+			params.Set("user", userID)
+		}
+		if len(types) > 0 {
+			params.Set("types", strings.Join(types, ","))
+		}
+		if opts.ExcludeArchived {
+			params.Set("exclude_archived", "true")
+		}
+		if opts.Limit > 0 {
+			params.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if cursor != "" {
+			params.Set("cursor", cursor)
+		}
+		s.client.withTeamID(params)
+
+		req, err := s.client.newGetRequest(ctx, "users.conversations", params)
+		if err != nil {
+			return nil, err
+		}
+
+		var response struct {
+			Channels         []Conversation   `json:"channels"`
+			ResponseMetadata ResponseMetadata `json:"response_metadata"`
+		}
+		if err := s.client.do(req, &response); err != nil {
+			return nil, err
+		}
+		all = append(all, response.Channels...)
+
+		cursor = strings.TrimSpace(response.ResponseMetadata.NextCursor)
+		if cursor == "" {
+			return all, nil
+		}
+	}
+}
+
+// SetUserStatus sets the custom status of the user owning the token via users.profile.set.
+// It requires a user token (xoxp-) with users.profile:write; bot tokens are rejected by Slack.
+// A zero expiration keeps the status until it is cleared; empty text and emoji clear it.
+func (s *UsersService) SetUserStatus(ctx context.Context, statusText, statusEmoji string, expiration time.Time) error {
+	if n := utf8.RuneCountInString(statusText); n > maxStatusTextLength {
+		return fmt.Errorf("slack: status text is %d characters, Slack allows at most %d", n, maxStatusTextLength)
+	}
+
+	var expiresAt int64
+	if !expiration.IsZero() {
+		expiresAt = expiration.Unix()
+	}
+	profile, err := json.Marshal(map[string]any{
+		"status_text":       statusText,
+		"status_emoji":      statusEmoji,
+		"status_expiration": expiresAt,
+	})
+	if err != nil {
+		return fmt.Errorf("slack: encode profile: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("profile", string(profile))
+
+	req, err := s.client.newFormRequest(ctx, "users.profile.set", form)
+	if err != nil {
+		return err
+	}
+	return s.client.do(req, nil)
+}
+
 func sleepWithContext(ctx context.Context, d time.Duration) error {
 	if d <= 0 {
 		return nil
@@ -2,6 +2,7 @@ package slack
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -425,3 +426,97 @@ func TestListUsersSlackError(t *testing.T) {
 		t.Fatalf("unexpected warning: %q", slackErr.Warning)
 	}
 }
+
+func TestSetUserStatus(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users.profile.set" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if r.Method != http.MethodPost {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("parse form: %v", err)
+		}
+		var profile map[string]any
+		if err := json.Unmarshal([]byte(r.PostForm.Get("profile")), &profile); err != nil {
+			t.Fatalf("decode profile: %v", err)
+		}
+		if len(profile) != 3 {
+			t.Fatalf("unexpected profile fields: %+v", profile)
+		}
+		if profile["status_text"] != "On call" || profile["status_emoji"] != ":pager:" || profile["status_expiration"] != float64(1700000000) {
+			t.Fatalf("unexpected profile: %+v", profile)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"profile":{}}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxp-test"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if err := client.Users().SetUserStatus(context.Background(), "On call", ":pager:", time.Unix(1700000000, 0)); err != nil {
+		t.Fatalf("SetUserStatus failed: %v", err)
+	}
+
+	err = client.Users().SetUserStatus(context.Background(), strings.Repeat("x", 101), ":pager:", time.Time{})
+	if err == nil || !strings.Contains(err.Error(), "at most 100") {
+		t.Fatalf("expected length validation error, got %v", err)
+	}
+}
+
+func TestListUserConversationsFollowsCursor(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users.conversations" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("user") != "U1" || q.Get("types") != "public_channel,private_channel" || q.Get("exclude_archived") != "true" || q.Get("team_id") != "T1" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		requests++
+
+		w.Header().Set("Content-Type", "application/json")
+		switch q.Get("cursor") {
+		case "":
+			_, _ = w.Write([]byte(`{"ok":true,"channels":[{"id":"C1","name":"general"}],"response_metadata":{"next_cursor":"dXNlcjpDMg=="}}`))
+		case "dXNlcjpDMg==":
+			_, _ = w.Write([]byte(`{"ok":true,"channels":[{"id":"C2","name":"ops","is_private":true}],"response_metadata":{"next_cursor":""}}`))
+		default:
+			t.Fatalf("unexpected cursor: %q", q.Get("cursor"))
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(WithBaseURL(srv.URL), WithToken("xoxb-test"), WithTeamID("T1"), WithTransport(transport.New()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	channels, err := client.Users().ListUserConversations(context.Background(), "U1", UserConversationsOptions{
+		ExcludeArchived: true,
+		Types:           []string{"public_channel", " private_channel"},
+	})
+	if err != nil {
+		t.Fatalf("ListUserConversations failed: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+	if len(channels) != 2 || channels[0].ID != "C1" || channels[1].Name != "ops" {
+		t.Fatalf("unexpected channels: %+v", channels)
+	}
+
+	if _, err := client.Users().ListUserConversations(context.Background(), "", UserConversationsOptions{Types: []string{"dm"}}); err == nil {
+		t.Fatalf("expected error for unknown conversation type")
+	}
+}
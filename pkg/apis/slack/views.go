@@ -3,6 +3,7 @@ package slack
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 )
 
@@ -12,6 +13,7 @@ type ViewsService struct {
 }
 
 // OpenView opens a view for the provided trigger.
+// Returns error matching ErrExpiredTrigger when the trigger ID has expired.
 func (s *ViewsService) OpenView(ctx context.Context, triggerID string, req *ModalViewRequest) (*OpenViewResult, error) {
 	if strings.TrimSpace(triggerID) == "" {
 		return nil, errors.New("slack: trigger ID is required")
@@ -32,6 +34,10 @@ func (s *ViewsService) OpenView(ctx context.Context, triggerID string, req *Moda
 
 	var result OpenViewResult
 	if err := s.client.do(httpReq, &result); err != nil {
+		var slackErr *Error
+		if errors.As(err, &slackErr) && slackErr.Code == "expired_trigger_id" {
+			return nil, fmt.Errorf("%w: %w", ErrExpiredTrigger, err)
+		}
 		return nil, err
 	}
 	return &result, nil
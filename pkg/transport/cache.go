@@ -0,0 +1,125 @@
+package transport
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// CachedResponse is a GET response body stored together with its ETag.
+type CachedResponse struct {
+	ETag       string
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Cache stores GET responses keyed by method and URL for ETag revalidation.
+type Cache interface {
+	Get(key string) (*CachedResponse, bool)
+	Set(key string, entry *CachedResponse)
+}
+
+// MemoryCache is a concurrency-safe in-memory Cache.
+type MemoryCache struct {
+	mu      sync.RWMutex
+	entries map[string]*CachedResponse
+}
+
+// NewMemoryCache creates an empty in-memory cache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]*CachedResponse)}
+}
+
+// Get returns cached entry by key.
+func (m *MemoryCache) Get(key string) (*CachedResponse, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	entry, ok := m.entries[key]
+	return entry, ok
+}
+
+// Set stores entry by key.
+func (m *MemoryCache) Set(key string, entry *CachedResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[key] = entry
+}
+
+// WithCache enables ETag/If-None-Match revalidation for GET requests.
+// Responses without ETag are never cached. Bodies above WithMaxResponseBytes
+// are not cached either; the request fails with ErrResponseTooLarge.
+func WithCache(cache Cache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+func requestCacheKey(req *http.Request) string {
+	return req.Method + " " + req.URL.String()
+}
+
+// cachedEntry returns cache entry usable for revalidating req.
+func (c *Client) cachedEntry(req *http.Request) (string, *CachedResponse) {
+	if c.cache == nil || req.Method != http.MethodGet {
+		return "", nil
+	}
+	key := requestCacheKey(req)
+	entry, ok := c.cache.Get(key)
+	if !ok || entry == nil || entry.ETag == "" {
+		return key, nil
+	}
+	return key, entry
+}
+
+// applyCache stores fresh responses carrying ETag and turns 304 into a
+// synthesized 200 built from the cached entry.
+func (c *Client) applyCache(req *http.Request, key string, entry *CachedResponse, resp *http.Response) (*http.Response, error) {
+	if key == "" {
+		return resp, nil
+	}
+
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		drainAndClose(resp.Body)
+		header := entry.Header.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", entry.StatusCode, http.StatusText(entry.StatusCode)),
+			StatusCode:    entry.StatusCode,
+			Proto:         resp.Proto,
+			ProtoMajor:    resp.ProtoMajor,
+			ProtoMinor:    resp.ProtoMinor,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(entry.Body)),
+			ContentLength: int64(len(entry.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	etag := resp.Header.Get("ETag")
+	if resp.StatusCode != http.StatusOK || etag == "" {
+		return resp, nil
+	}
+
+	var reader io.Reader = resp.Body
+	if c.maxResponse > 0 {
+		reader = &maxBytesReader{r: resp.Body, remaining: c.maxResponse}
+	}
+	body, err := io.ReadAll(reader)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("transport: read response for cache: %w", err)
+	}
+	c.cache.Set(key, &CachedResponse{
+		ETag:       etag,
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       body,
+	})
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
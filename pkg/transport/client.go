@@ -9,23 +9,67 @@ import (
 	"math/rand"
 	"net"
 	"net/http"
+	"net/url"
+	"strings"
 	"sync"
 	"time"
 )
 
 const defaultErrorBodyLimit int64 = 4096
 
+// Version is the suptech-go-kit release reported in the default User-Agent.
+const Version = "0.1.0"
+
+// DefaultUserAgent is sent with every request unless overridden.
+const DefaultUserAgent = "suptech-go-kit/" + Version
+
 // Logger describes the minimal logging API used by the transport client.
 type Logger interface {
 	Printf(format string, args ...any)
 }
 
+// ContextLogger is an optional Logger extension. When the configured logger
+// implements it, request log lines go to PrintfContext with the request
+// context so the logger can attach trace or request IDs.
+type ContextLogger interface {
+	PrintfContext(ctx context.Context, format string, args ...any)
+}
+
+// Metrics receives per-attempt request observations, e.g. to feed
+// Prometheus counters and histograms. statusCode is 0 when err is non-nil.
+type Metrics interface {
+	ObserveRequest(method, host string, statusCode int, attempt int, duration time.Duration, err error)
+}
+
+// BackoffStrategy selects how retry delays are computed.
+type BackoffStrategy int
+
+const (
+	// BackoffExponential doubles InitialBackoff per attempt (capped at
+	// MaxBackoff) and adds random [0, Jitter) on top. This is the default.
+	BackoffExponential BackoffStrategy = iota
+	// BackoffFullJitter picks a random delay in [0, exponential cap], which
+	// decorrelates retries of many clients hitting the same throttled host.
+	BackoffFullJitter
+)
+
 // RetryConfig controls retry behavior for transient failures.
 type RetryConfig struct {
 	MaxAttempts    int
 	InitialBackoff time.Duration
 	MaxBackoff     time.Duration
 	Jitter         time.Duration
+	// Strategy selects backoff computation. Jitter is ignored for BackoffFullJitter.
+	Strategy BackoffStrategy
+	// MaxElapsedTime bounds the total time spent across attempts and
+	// backoffs. Zero means no budget beyond MaxAttempts.
+	MaxElapsedTime time.Duration
+	// MaxRetryAfter caps how long a server-specified Retry-After is honored.
+	// Zero honors Retry-After verbatim. Longer delays are clamped to
+	// MaxRetryAfter when RespectRetryAfter is set and otherwise replaced by
+	// the regular exponential backoff.
+	MaxRetryAfter     time.Duration
+	RespectRetryAfter bool
 }
 
 var defaultRetryConfig = RetryConfig{
@@ -38,10 +82,23 @@ var defaultRetryConfig = RetryConfig{
 // Client is a shared HTTP layer for all API packages.
 type Client struct {
 	httpClient     *http.Client
+	customHTTP     bool
+	pool           *connectionPool
 	retry          RetryConfig
+	hostRetry      map[string]RetryConfig
 	logger         Logger
+	metrics        Metrics
 	baseHeaders    http.Header
+	userAgent      string
 	errorBodyLimit int64
+	maxResponse    int64
+	strictJSON     bool
+	compressMin    int
+	hedgeDelay     time.Duration
+	maxHedges      int
+	cache          Cache
+	sem            chan struct{}
+	responseHook   func(*http.Response)
 
 	randMu sync.Mutex
 	rand   *rand.Rand
@@ -56,10 +113,56 @@ func New(opts ...Option) *Client {
 		httpClient:     &http.Client{Timeout: 30 * time.Second},
 		retry:          normalizeRetryConfig(defaultRetryConfig),
 		baseHeaders:    http.Header{},
+		userAgent:      DefaultUserAgent,
 		errorBodyLimit: defaultErrorBodyLimit,
 		rand:           rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 
+	c.apply(opts)
+	return c
+}
+
+// Clone returns an independent copy of the client with opts applied on top
+// of the current configuration. The underlying http.Client value, base
+// headers, concurrency limit and random source are not shared with c;
+// logger, metrics, cache and response hook are.
+func (c *Client) Clone(opts ...Option) *Client {
+	clone := &Client{
+		retry:          c.retry,
+		customHTTP:     c.customHTTP,
+		logger:         c.logger,
+		metrics:        c.metrics,
+		baseHeaders:    c.baseHeaders.Clone(),
+		userAgent:      c.userAgent,
+		errorBodyLimit: c.errorBodyLimit,
+		maxResponse:    c.maxResponse,
+		strictJSON:     c.strictJSON,
+		compressMin:    c.compressMin,
+		hedgeDelay:     c.hedgeDelay,
+		maxHedges:      c.maxHedges,
+		cache:          c.cache,
+		responseHook:   c.responseHook,
+		rand:           rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	if c.httpClient != nil {
+		httpClient := *c.httpClient
+		clone.httpClient = &httpClient
+	}
+	if c.sem != nil {
+		clone.sem = make(chan struct{}, cap(c.sem))
+	}
+	if len(c.hostRetry) > 0 {
+		clone.hostRetry = make(map[string]RetryConfig, len(c.hostRetry))
+		for host, cfg := range c.hostRetry {
+			clone.hostRetry[host] = cfg
+		}
+	}
+
+	clone.apply(opts)
+	return clone
+}
+
+func (c *Client) apply(opts []Option) {
 	for _, opt := range opts {
 		if opt != nil {
 			opt(c)
@@ -73,11 +176,15 @@ func New(opts ...Option) *Client {
 	if c.httpClient == nil {
 		c.httpClient = &http.Client{Timeout: 30 * time.Second}
 	}
+	if c.pool != nil {
+		if !c.customHTTP {
+			c.httpClient.Transport = c.pool.transport()
+		}
+		c.pool = nil
+	}
 	if c.errorBodyLimit <= 0 {
 		c.errorBodyLimit = defaultErrorBodyLimit
 	}
-
-	return c
 }
 
 // WithHTTPClient injects custom HTTP client instance.
@@ -85,10 +192,36 @@ func WithHTTPClient(client *http.Client) Option {
 	return func(c *Client) {
 		if client != nil {
 			c.httpClient = client
+			c.customHTTP = true
+		}
+	}
+}
+
+// WithRoundTripperFunc sends every request through fn instead of the network,
+// so tests can stub responses without an httptest server. It takes precedence
+// over WithConnectionPool; an injected WithHTTPClient client is copied, not
+// modified.
+func WithRoundTripperFunc(fn func(*http.Request) (*http.Response, error)) Option {
+	return func(c *Client) {
+		if fn == nil {
+			return
 		}
+		httpClient := http.Client{Timeout: 30 * time.Second}
+		if c.httpClient != nil {
+			httpClient = *c.httpClient
+		}
+		httpClient.Transport = roundTripperFunc(fn)
+		c.httpClient = &httpClient
+		c.customHTTP = true
 	}
 }
 
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
 // WithTimeout sets a client-level timeout.
 func WithTimeout(timeout time.Duration) Option {
 	return func(c *Client) {
@@ -102,6 +235,43 @@ func WithTimeout(timeout time.Duration) Option {
 	}
 }
 
+// connectionPool holds WithConnectionPool settings until apply builds the transport.
+type connectionPool struct {
+	maxIdlePerHost  int
+	maxConnsPerHost int
+	idleTimeout     time.Duration
+}
+
+func (p *connectionPool) transport() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	if p.maxIdlePerHost > 0 {
+		t.MaxIdleConnsPerHost = p.maxIdlePerHost
+		t.MaxIdleConns = max(t.MaxIdleConns, p.maxIdlePerHost)
+	}
+	if p.maxConnsPerHost > 0 {
+		t.MaxConnsPerHost = p.maxConnsPerHost
+	}
+	if p.idleTimeout > 0 {
+		t.IdleConnTimeout = p.idleTimeout
+	}
+	return t
+}
+
+// WithConnectionPool replaces the HTTP transport with a clone of
+// http.DefaultTransport (HTTP/2 enabled) tuned for high fan-out: idle
+// connections kept per host, total connections per host and idle timeout.
+// Values <= 0 keep the defaults. It is ignored when WithHTTPClient injects a
+// client, in any option order; tune that client's transport directly instead.
+func WithConnectionPool(maxIdlePerHost, maxConnsPerHost int, idleTimeout time.Duration) Option {
+	return func(c *Client) {
+		c.pool = &connectionPool{
+			maxIdlePerHost:  maxIdlePerHost,
+			maxConnsPerHost: maxConnsPerHost,
+			idleTimeout:     idleTimeout,
+		}
+	}
+}
+
 // WithRetry overrides retry policy.
 func WithRetry(cfg RetryConfig) Option {
 	return func(c *Client) {
@@ -109,6 +279,22 @@ func WithRetry(cfg RetryConfig) Option {
 	}
 }
 
+// WithHostRetry overrides retry policy for requests whose URL host matches
+// host (compared case-insensitively, with or without port). Other hosts use
+// the WithRetry policy.
+func WithHostRetry(host string, cfg RetryConfig) Option {
+	return func(c *Client) {
+		host = strings.ToLower(strings.TrimSpace(host))
+		if host == "" {
+			return
+		}
+		if c.hostRetry == nil {
+			c.hostRetry = make(map[string]RetryConfig)
+		}
+		c.hostRetry[host] = normalizeRetryConfig(cfg)
+	}
+}
+
 // WithLogger configures request logging.
 func WithLogger(logger Logger) Option {
 	return func(c *Client) {
@@ -116,6 +302,13 @@ func WithLogger(logger Logger) Option {
 	}
 }
 
+// WithMetrics configures per-attempt request metrics sink.
+func WithMetrics(metrics Metrics) Option {
+	return func(c *Client) {
+		c.metrics = metrics
+	}
+}
+
 // WithBaseHeaders applies headers to every request unless already present.
 func WithBaseHeaders(headers http.Header) Option {
 	return func(c *Client) {
@@ -133,6 +326,16 @@ func WithBaseHeaders(headers http.Header) Option {
 	}
 }
 
+// WithUserAgent sets default User-Agent header. Requests that already carry
+// User-Agent keep their own value. Empty value keeps DefaultUserAgent.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		if strings.TrimSpace(userAgent) != "" {
+			c.userAgent = userAgent
+		}
+	}
+}
+
 // WithErrorBodyLimit changes max amount of response body captured in APIError.
 func WithErrorBodyLimit(limit int64) Option {
 	return func(c *Client) {
@@ -142,50 +345,145 @@ func WithErrorBodyLimit(limit int64) Option {
 	}
 }
 
+// WithMaxResponseBytes caps successful response bodies decoded by DoJSON or
+// buffered for WithCache. Larger bodies fail with ErrResponseTooLarge. Values
+// <= 0 mean unlimited (the default).
+func WithMaxResponseBytes(n int64) Option {
+	return func(c *Client) {
+		if n < 0 {
+			n = 0
+		}
+		c.maxResponse = n
+	}
+}
+
+// WithStrictJSON makes DoJSON reject response fields that the target type
+// does not declare, surfacing API drift in tests or staging. Off by default.
+func WithStrictJSON(strict bool) Option {
+	return func(c *Client) {
+		c.strictJSON = strict
+	}
+}
+
+// WithMaxConcurrent bounds the number of requests in flight per client.
+// A request occupies a slot until its response body is closed; callers over
+// the limit block until a slot frees up or the request context is done.
+// Values <= 0 disable the limit.
+func WithMaxConcurrent(n int) Option {
+	return func(c *Client) {
+		if n <= 0 {
+			c.sem = nil
+			return
+		}
+		c.sem = make(chan struct{}, n)
+	}
+}
+
+// WithResponseHook registers hook called for every HTTP response, including
+// ones that are retried afterwards, before retry decisions are made. The hook
+// receives a copy with cloned headers and an empty body, so it can inspect
+// status and headers (e.g. X-RateLimit-Remaining) without consuming the body.
+func WithResponseHook(hook func(*http.Response)) Option {
+	return func(c *Client) {
+		c.responseHook = hook
+	}
+}
+
 // Do executes request with retries for transient failures.
 func (c *Client) Do(req *http.Request) (*http.Response, error) {
 	if req == nil {
 		return nil, errors.New("transport: request is nil")
 	}
+	req, err := c.compressRequest(req)
+	if err != nil {
+		return nil, err
+	}
 
-	attempts := c.retry.MaxAttempts
+	policy := c.retryPolicy(req.URL)
+	attempts := policy.MaxAttempts
 	replayable := req.Body == nil || req.GetBody != nil
 	if !replayable {
 		attempts = 1
 	}
 
+	cacheKey, cached := c.cachedEntry(req)
+
+	started := time.Now()
 	var lastErr error
 	for attempt := 1; attempt <= attempts; attempt++ {
 		attemptReq, err := c.requestForAttempt(req, attempt)
 		if err != nil {
 			return nil, err
 		}
+		for key, values := range HeadersFromContext(req.Context()) {
+			if attemptReq.Header.Get(key) == "" {
+				attemptReq.Header[key] = append([]string(nil), values...)
+			}
+		}
 		c.applyBaseHeaders(attemptReq.Header)
+		if id := RequestIDFromContext(req.Context()); id != "" && attemptReq.Header.Get(RequestIDHeader) == "" {
+			attemptReq.Header.Set(RequestIDHeader, id)
+		}
+		if key := IdempotencyKeyFromContext(req.Context()); key != "" && attemptReq.Header.Get(IdempotencyKeyHeader) == "" {
+			attemptReq.Header.Set(IdempotencyKeyHeader, key)
+		}
+		if cached != nil && attemptReq.Header.Get("If-None-Match") == "" {
+			attemptReq.Header.Set("If-None-Match", cached.ETag)
+		}
 
-		resp, err := c.httpClient.Do(attemptReq)
+		release, err := c.acquire(req.Context())
+		if err != nil {
+			return nil, err
+		}
+		attemptStarted := time.Now()
+		resp, err := c.send(attemptReq)
+		elapsed := time.Since(attemptStarted)
+		c.observe(req, resp, attempt, elapsed, err)
 		if err != nil {
+			release()
 			if !shouldRetryError(err) || attempt == attempts {
 				return nil, err
 			}
+			backoff, ok := backoffWithinDeadline(req.Context(), c.nextBackoff(policy, attempt, 0))
+			if !ok || !withinRetryBudget(policy, started, backoff) {
+				return nil, err
+			}
 			lastErr = err
-			if sleepErr := sleepWithContext(req.Context(), c.nextBackoff(attempt, 0)); sleepErr != nil {
+			if c.logger != nil {
+				c.logf(req.Context(), "transport: retrying %s %s after %s due to err=%v (attempt %d/%d)", req.Method, req.URL.Redacted(), backoff, err, attempt, attempts)
+			}
+			if sleepErr := sleepWithContext(req.Context(), backoff); sleepErr != nil {
 				return nil, sleepErr
 			}
 			continue
 		}
+		resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+		c.runResponseHook(resp)
 
 		if shouldRetryStatus(resp.StatusCode) && attempt < attempts {
-			drainAndClose(resp.Body)
-			if sleepErr := sleepWithContext(req.Context(), c.nextBackoff(attempt, parseRetryAfter(resp.Header.Get("Retry-After")))); sleepErr != nil {
-				return nil, sleepErr
+			// When the context deadline would expire during backoff, return
+			// the current response instead of sleeping into a context error.
+			backoff, ok := backoffWithinDeadline(req.Context(), c.nextBackoff(policy, attempt, parseRetryAfter(resp.Header.Get("Retry-After"))))
+			if ok && withinRetryBudget(policy, started, backoff) {
+				drainAndClose(resp.Body)
+				if c.logger != nil {
+					c.logf(req.Context(), "transport: retrying %s %s after %s due to status=%d (attempt %d/%d)", req.Method, req.URL.Redacted(), backoff, resp.StatusCode, attempt, attempts)
+				}
+				if sleepErr := sleepWithContext(req.Context(), backoff); sleepErr != nil {
+					return nil, sleepErr
+				}
+				continue
 			}
-			continue
 		}
 
 		if c.logger != nil {
-			c.logger.Printf("transport: %s %s -> %d (attempt=%d)", req.Method, req.URL.Redacted(), resp.StatusCode, attempt)
+			if id := attemptReq.Header.Get(RequestIDHeader); id != "" {
+				c.logf(req.Context(), "transport: %s %s -> %d in %s (attempt=%d request_id=%s)", req.Method, req.URL.Redacted(), resp.StatusCode, elapsed, attempt, id)
+			} else {
+				c.logf(req.Context(), "transport: %s %s -> %d in %s (attempt=%d)", req.Method, req.URL.Redacted(), resp.StatusCode, elapsed, attempt)
+			}
 		}
-		return resp, nil
+		return c.applyCache(req, cacheKey, cached, resp)
 	}
 
 	if lastErr != nil {
@@ -194,6 +492,27 @@ func (c *Client) Do(req *http.Request) (*http.Response, error) {
 	return nil, errors.New("transport: request failed after retries")
 }
 
+// logf writes to the configured logger, preferring ContextLogger.
+func (c *Client) logf(ctx context.Context, format string, args ...any) {
+	if logger, ok := c.logger.(ContextLogger); ok {
+		logger.PrintfContext(ctx, format, args...)
+		return
+	}
+	c.logger.Printf(format, args...)
+}
+
+// runResponseHook passes a read-only view of resp to the configured hook.
+func (c *Client) runResponseHook(resp *http.Response) {
+	if c.responseHook == nil {
+		return
+	}
+	view := *resp
+	view.Header = resp.Header.Clone()
+	view.Trailer = nil
+	view.Body = http.NoBody
+	c.responseHook(&view)
+}
+
 // DoJSON executes request and decodes JSON body for successful responses.
 func (c *Client) DoJSON(req *http.Request, out any) error {
 	if req == nil {
@@ -218,7 +537,15 @@ func (c *Client) DoJSON(req *http.Request, out any) error {
 		return nil
 	}
 
-	dec := json.NewDecoder(resp.Body)
+	var body io.Reader = resp.Body
+	if c.maxResponse > 0 {
+		body = &maxBytesReader{r: resp.Body, remaining: c.maxResponse}
+	}
+
+	dec := json.NewDecoder(body)
+	if c.strictJSON {
+		dec.DisallowUnknownFields()
+	}
 	if err := dec.Decode(out); err != nil {
 		if errors.Is(err, io.EOF) {
 			return nil
@@ -229,6 +556,71 @@ func (c *Client) DoJSON(req *http.Request, out any) error {
 	return nil
 }
 
+// DoBytes executes request and returns body and headers of a successful
+// response. Non-2xx responses are returned as *APIError.
+func (c *Client) DoBytes(req *http.Request) ([]byte, http.Header, error) {
+	if req == nil {
+		return nil, nil, errors.New("transport: request is nil")
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, nil, NewAPIError(resp, c.errorBodyLimit)
+	}
+
+	var body io.Reader = resp.Body
+	if c.maxResponse > 0 {
+		body = &maxBytesReader{r: resp.Body, remaining: c.maxResponse}
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("transport: read response body: %w", err)
+	}
+	return data, resp.Header, nil
+}
+
+func (c *Client) observe(req *http.Request, resp *http.Response, attempt int, duration time.Duration, err error) {
+	if c.metrics == nil {
+		return
+	}
+	statusCode := 0
+	if err == nil && resp != nil {
+		statusCode = resp.StatusCode
+	}
+	c.metrics.ObserveRequest(req.Method, req.URL.Host, statusCode, attempt, duration, err)
+}
+
+// acquire takes a concurrency slot and returns func releasing it.
+func (c *Client) acquire(ctx context.Context) (func(), error) {
+	if c.sem == nil {
+		return func() {}, nil
+	}
+	select {
+	case c.sem <- struct{}{}:
+		var once sync.Once
+		return func() { once.Do(func() { <-c.sem }) }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// releaseOnClose frees concurrency slot once response body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	r.release()
+	return err
+}
+
 func (c *Client) requestForAttempt(req *http.Request, attempt int) (*http.Request, error) {
 	clone := req.Clone(req.Context())
 	clone.Header = req.Header.Clone()
@@ -261,35 +653,87 @@ func (c *Client) applyBaseHeaders(headers http.Header) {
 			headers.Add(key, value)
 		}
 	}
+	if c.userAgent != "" && headers.Get("User-Agent") == "" {
+		headers.Set("User-Agent", c.userAgent)
+	}
 }
 
-func (c *Client) nextBackoff(attempt int, retryAfter time.Duration) time.Duration {
+func (c *Client) nextBackoff(policy RetryConfig, attempt int, retryAfter time.Duration) time.Duration {
 	if retryAfter > 0 {
-		return retryAfter
+		if policy.MaxRetryAfter <= 0 || retryAfter <= policy.MaxRetryAfter {
+			return retryAfter
+		}
+		if policy.RespectRetryAfter {
+			return policy.MaxRetryAfter
+		}
 	}
 
-	backoff := c.retry.InitialBackoff
+	backoff := policy.InitialBackoff
 	for i := 1; i < attempt; i++ {
 		backoff *= 2
-		if backoff >= c.retry.MaxBackoff {
-			backoff = c.retry.MaxBackoff
+		if backoff >= policy.MaxBackoff {
+			backoff = policy.MaxBackoff
 			break
 		}
 	}
 
-	if c.retry.Jitter > 0 {
+	if policy.Strategy == BackoffFullJitter {
+		c.randMu.Lock()
+		backoff = time.Duration(c.rand.Int63n(int64(backoff) + 1))
+		c.randMu.Unlock()
+		return backoff
+	}
+
+	if policy.Jitter > 0 {
 		c.randMu.Lock()
-		backoff += time.Duration(c.rand.Int63n(int64(c.retry.Jitter)))
+		backoff += time.Duration(c.rand.Int63n(int64(policy.Jitter)))
 		c.randMu.Unlock()
 	}
 
-	if backoff > c.retry.MaxBackoff {
-		backoff = c.retry.MaxBackoff
+	if backoff > policy.MaxBackoff {
+		backoff = policy.MaxBackoff
 	}
 
 	return backoff
 }
 
+// backoffWithinDeadline reports whether sleeping for backoff still leaves
+// time before the context deadline for another attempt.
+func backoffWithinDeadline(ctx context.Context, backoff time.Duration) (time.Duration, bool) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return backoff, true
+	}
+	remaining := time.Until(deadline)
+	if remaining <= backoff {
+		return 0, false
+	}
+	return backoff, true
+}
+
+// withinRetryBudget reports whether sleeping for backoff keeps the total
+// elapsed time since started within RetryConfig.MaxElapsedTime.
+func withinRetryBudget(policy RetryConfig, started time.Time, backoff time.Duration) bool {
+	if policy.MaxElapsedTime <= 0 {
+		return true
+	}
+	return time.Since(started)+backoff < policy.MaxElapsedTime
+}
+
+// retryPolicy returns the WithHostRetry policy matching u, or the default.
+func (c *Client) retryPolicy(u *url.URL) RetryConfig {
+	if len(c.hostRetry) == 0 || u == nil {
+		return c.retry
+	}
+	if cfg, ok := c.hostRetry[strings.ToLower(u.Host)]; ok {
+		return cfg
+	}
+	if cfg, ok := c.hostRetry[strings.ToLower(u.Hostname())]; ok {
+		return cfg
+	}
+	return c.retry
+}
+
 func normalizeRetryConfig(cfg RetryConfig) RetryConfig {
 	if cfg.MaxAttempts <= 0 {
 		cfg.MaxAttempts = defaultRetryConfig.MaxAttempts
@@ -306,6 +750,12 @@ func normalizeRetryConfig(cfg RetryConfig) RetryConfig {
 	if cfg.Jitter < 0 {
 		cfg.Jitter = 0
 	}
+	if cfg.MaxElapsedTime < 0 {
+		cfg.MaxElapsedTime = 0
+	}
+	if cfg.MaxRetryAfter < 0 {
+		cfg.MaxRetryAfter = 0
+	}
 	return cfg
 }
 
@@ -1,10 +1,18 @@
 package transport
 
 import (
+	"compress/gzip"
+	"context"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"regexp"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -106,3 +114,1125 @@ func TestDoAppliesBaseHeaders(t *testing.T) {
 	}
 	_ = resp.Body.Close()
 }
+
+func TestDoSetsUserAgent(t *testing.T) {
+	t.Parallel()
+
+	var got []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("User-Agent"))
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	send := func(client *Client, userAgent string) {
+		req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+		if err != nil {
+			t.Fatalf("new request: %v", err)
+		}
+		if userAgent != "" {
+			req.Header.Set("User-Agent", userAgent)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("Do failed: %v", err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	send(New(), "")
+	send(New(WithUserAgent("incident-bot/2.0")), "")
+	send(New(WithUserAgent("incident-bot/2.0")), "per-request/1.0")
+
+	want := []string{DefaultUserAgent, "incident-bot/2.0", "per-request/1.0"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d requests, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("request %d: expected User-Agent %q, got %q", i, want[i], got[i])
+		}
+	}
+}
+
+func TestDoJSONServesCachedBodyOnNotModified(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			if got := r.Header.Get("If-None-Match"); got != "" {
+				t.Fatalf("expected no If-None-Match on first call, got %q", got)
+			}
+			w.Header().Set("ETag", `"v1"`)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"name":"schema"}`))
+			return
+		}
+		if got := r.Header.Get("If-None-Match"); got != `"v1"` {
+			t.Fatalf("expected If-None-Match \"v1\", got %q", got)
+		}
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	defer srv.Close()
+
+	client := New(WithCache(NewMemoryCache()))
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequest(http.MethodGet, srv.URL+"/schema", nil)
+		if err != nil {
+			t.Fatalf("new request: %v", err)
+		}
+		var out struct {
+			Name string `json:"name"`
+		}
+		if err := client.DoJSON(req, &out); err != nil {
+			t.Fatalf("DoJSON call %d failed: %v", i+1, err)
+		}
+		if out.Name != "schema" {
+			t.Fatalf("call %d: unexpected body: %+v", i+1, out)
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 server calls, got %d", calls)
+	}
+}
+
+func TestDoLimitsConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		inFlight int
+		maxSeen  int
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxSeen {
+			maxSeen = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	client := New(WithMaxConcurrent(2))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+			if err != nil {
+				errs <- err
+				return
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				errs <- err
+				return
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("request failed: %v", err)
+	}
+	if maxSeen > 2 {
+		t.Fatalf("expected at most 2 concurrent requests, observed %d", maxSeen)
+	}
+	if maxSeen == 0 {
+		t.Fatalf("expected requests to reach the server")
+	}
+}
+
+func TestDoMaxConcurrentHonorsContext(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	client := New(WithMaxConcurrent(1))
+
+	first, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	held, err := client.Do(first)
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	defer held.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	second, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	if _, err := client.Do(second); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestCloneInheritsConfigAndOverridesHeaders(t *testing.T) {
+	t.Parallel()
+
+	base := New(
+		WithRetry(RetryConfig{MaxAttempts: 5, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}),
+		WithTimeout(5*time.Second),
+		WithBaseHeaders(http.Header{"X-Api": []string{"jira"}}),
+	)
+	clone := base.Clone(
+		WithBaseHeaders(http.Header{"X-Clone": []string{"slack"}}),
+		WithTimeout(time.Second),
+	)
+
+	if clone.retry.MaxAttempts != 5 {
+		t.Fatalf("expected clone to inherit MaxAttempts=5, got %d", clone.retry.MaxAttempts)
+	}
+	if clone.httpClient.Timeout != time.Second {
+		t.Fatalf("unexpected clone timeout: %v", clone.httpClient.Timeout)
+	}
+	if base.httpClient.Timeout != 5*time.Second {
+		t.Fatalf("clone must not change base timeout, got %v", base.httpClient.Timeout)
+	}
+	if base.baseHeaders.Get("X-Clone") != "" {
+		t.Fatalf("clone must not change base headers")
+	}
+	if clone.baseHeaders.Get("X-Api") != "jira" || clone.baseHeaders.Get("X-Clone") != "slack" {
+		t.Fatalf("unexpected clone headers: %v", clone.baseHeaders)
+	}
+	if clone.rand == base.rand {
+		t.Fatalf("expected clone to get its own random source")
+	}
+
+	attempts := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	resp, err := clone.Do(req)
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	_ = resp.Body.Close()
+	if attempts != 5 {
+		t.Fatalf("expected clone to retry 5 times, got %d", attempts)
+	}
+}
+
+func TestDoSkipsBackoffPastContextDeadline(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("busy"))
+	}))
+	defer srv.Close()
+
+	client := New(WithRetry(RetryConfig{
+		MaxAttempts:    5,
+		InitialBackoff: 2 * time.Second,
+		MaxBackoff:     2 * time.Second,
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+
+	started := time.Now()
+	err = client.DoJSON(req, nil)
+	elapsed := time.Since(started)
+
+	if elapsed > 150*time.Millisecond {
+		t.Fatalf("expected fast failure, took %v", elapsed)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 APIError, got %v", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected a single attempt, got %d", attempts)
+	}
+}
+
+func TestWithHostRetryOverridesPolicyPerHost(t *testing.T) {
+	t.Parallel()
+
+	var strictAttempts, defaultAttempts atomic.Int32
+	strict := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		strictAttempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer strict.Close()
+	lenient := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defaultAttempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer lenient.Close()
+
+	strictURL, err := url.Parse(strict.URL)
+	if err != nil {
+		t.Fatalf("parse URL: %v", err)
+	}
+	fast := RetryConfig{InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	global, host := fast, fast
+	global.MaxAttempts = 4
+	host.MaxAttempts = 2
+	client := New(WithRetry(global), WithHostRetry(strings.ToUpper(strictURL.Host), host))
+
+	for _, target := range []string{strict.URL, lenient.URL} {
+		req, err := http.NewRequest(http.MethodGet, target, nil)
+		if err != nil {
+			t.Fatalf("new request: %v", err)
+		}
+		if err := client.DoJSON(req, nil); err == nil {
+			t.Fatalf("expected error for %s", target)
+		}
+	}
+
+	if got := strictAttempts.Load(); got != 2 {
+		t.Fatalf("expected host override of 2 attempts, got %d", got)
+	}
+	if got := defaultAttempts.Load(); got != 4 {
+		t.Fatalf("expected global policy of 4 attempts, got %d", got)
+	}
+	if got := client.Clone().retryPolicy(strictURL).MaxAttempts; got != 2 {
+		t.Fatalf("expected clone to keep host policy, got %d attempts", got)
+	}
+}
+
+func TestDoStopsRetryingAtMaxElapsedTime(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	client := New(WithRetry(RetryConfig{
+		MaxAttempts:    10,
+		InitialBackoff: 20 * time.Millisecond,
+		MaxBackoff:     20 * time.Millisecond,
+		MaxElapsedTime: 50 * time.Millisecond,
+	}))
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+
+	err = client.DoJSON(req, nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("expected 502 APIError, got %v", err)
+	}
+	if attempts < 2 || attempts > 3 {
+		t.Fatalf("expected budget to stop retries after 2-3 attempts, got %d", attempts)
+	}
+}
+
+func TestNextBackoffFullJitterStaysWithinCap(t *testing.T) {
+	t.Parallel()
+
+	client := New(WithRetry(RetryConfig{
+		MaxAttempts:    5,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Jitter:         time.Hour, // ignored by full jitter
+		Strategy:       BackoffFullJitter,
+	}))
+
+	caps := map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		3: 400 * time.Millisecond,
+		4: 800 * time.Millisecond,
+		5: time.Second,
+		6: time.Second,
+	}
+	for attempt, limit := range caps {
+		for i := 0; i < 200; i++ {
+			got := client.nextBackoff(client.retry, attempt, 0)
+			if got < 0 || got > limit {
+				t.Fatalf("attempt %d: backoff %v outside [0, %v]", attempt, got, limit)
+			}
+		}
+	}
+
+	if got := client.nextBackoff(client.retry, 1, 3*time.Second); got != 3*time.Second {
+		t.Fatalf("expected Retry-After to take precedence, got %v", got)
+	}
+}
+
+func TestNextBackoffCapsRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	retryAfter := parseRetryAfter("3600")
+	if retryAfter != time.Hour {
+		t.Fatalf("unexpected parsed Retry-After: %v", retryAfter)
+	}
+
+	client := New()
+	unlimited := RetryConfig{InitialBackoff: time.Second, MaxBackoff: 2 * time.Second}
+	if got := client.nextBackoff(normalizeRetryConfig(unlimited), 1, retryAfter); got != time.Hour {
+		t.Fatalf("expected Retry-After to be honored verbatim without cap, got %v", got)
+	}
+
+	capped := unlimited
+	capped.MaxRetryAfter = 30 * time.Second
+	capped.RespectRetryAfter = true
+	if got := client.nextBackoff(normalizeRetryConfig(capped), 1, retryAfter); got != 30*time.Second {
+		t.Fatalf("expected capped sleep of 30s, got %v", got)
+	}
+	if got := client.nextBackoff(normalizeRetryConfig(capped), 1, 10*time.Second); got != 10*time.Second {
+		t.Fatalf("expected Retry-After under cap to be honored, got %v", got)
+	}
+
+	fallback := capped
+	fallback.RespectRetryAfter = false
+	if got := client.nextBackoff(normalizeRetryConfig(fallback), 2, retryAfter); got > 2*time.Second {
+		t.Fatalf("expected exponential backoff beyond cap, got %v", got)
+	}
+}
+
+type recordedObservation struct {
+	method     string
+	host       string
+	statusCode int
+	attempt    int
+	err        error
+}
+
+type fakeMetrics struct {
+	mu           sync.Mutex
+	observations []recordedObservation
+}
+
+func (m *fakeMetrics) ObserveRequest(method, host string, statusCode int, attempt int, duration time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.observations = append(m.observations, recordedObservation{
+		method:     method,
+		host:       host,
+		statusCode: statusCode,
+		attempt:    attempt,
+		err:        err,
+	})
+}
+
+func TestDoReportsMetricsPerAttempt(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	metrics := &fakeMetrics{}
+	client := New(
+		WithMetrics(metrics),
+		WithRetry(RetryConfig{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}),
+	)
+
+	req, err := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	if err := client.DoJSON(req, nil); err != nil {
+		t.Fatalf("DoJSON failed: %v", err)
+	}
+
+	if len(metrics.observations) != 3 {
+		t.Fatalf("expected 3 observations, got %d", len(metrics.observations))
+	}
+	wantStatus := []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK}
+	host := strings.TrimPrefix(srv.URL, "http://")
+	for i, obs := range metrics.observations {
+		if obs.attempt != i+1 {
+			t.Fatalf("observation %d: expected attempt %d, got %d", i, i+1, obs.attempt)
+		}
+		if obs.statusCode != wantStatus[i] {
+			t.Fatalf("observation %d: expected status %d, got %d", i, wantStatus[i], obs.statusCode)
+		}
+		if obs.method != http.MethodPost || obs.host != host || obs.err != nil {
+			t.Fatalf("observation %d: unexpected values %+v", i, obs)
+		}
+	}
+}
+
+func TestDoSendsSameIdempotencyKeyOnRetries(t *testing.T) {
+	t.Parallel()
+
+	var seen []string
+	var mu sync.Mutex
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen = append(seen, r.Header.Get("Idempotency-Key"))
+		attempt := len(seen)
+		mu.Unlock()
+		if attempt < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	client := New(WithRetry(RetryConfig{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}))
+	key := NewIdempotencyKey()
+	if len(key) != 36 || key[14] != '4' {
+		t.Fatalf("unexpected key format: %q", key)
+	}
+	if other := NewIdempotencyKey(); other == key {
+		t.Fatalf("expected unique keys, got %q twice", key)
+	}
+
+	ctx := ContextWithIdempotencyKey(context.Background(), key)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL, strings.NewReader(`{"summary":"x"}`))
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(seen) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(seen))
+	}
+	for i, got := range seen {
+		if got != key {
+			t.Fatalf("attempt %d: expected key %q, got %q", i+1, key, got)
+		}
+	}
+}
+
+func TestDoPropagatesRequestIDFromContext(t *testing.T) {
+	t.Parallel()
+
+	var seen []string
+	var mu sync.Mutex
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen = append(seen, r.Header.Get("X-Request-Id"))
+		mu.Unlock()
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	client := New()
+	ctx := ContextWithRequestID(context.Background(), "req-42")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	req.Header.Set("X-Request-Id", "explicit")
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(seen) != 2 || seen[0] != "req-42" || seen[1] != "explicit" {
+		t.Fatalf("unexpected request IDs: %v", seen)
+	}
+	if got := RequestIDFromContext(context.Background()); got != "" {
+		t.Fatalf("expected empty request ID, got %q", got)
+	}
+}
+
+func TestDoJSONRejectsOversizedResponse(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items":[`))
+		for i := 0; i < 10000; i++ {
+			_, _ = w.Write([]byte(`"xxxxxxxxxxxxxxxx",`))
+		}
+		_, _ = w.Write([]byte(`"end"]}`))
+	}))
+	defer srv.Close()
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	var out map[string]any
+	err = New(WithMaxResponseBytes(1024)).DoJSON(req, &out)
+	if !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("expected ErrResponseTooLarge, got %v", err)
+	}
+
+	req, err = http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	if err := New(WithMaxResponseBytes(1<<20)).DoJSON(req, &out); err != nil {
+		t.Fatalf("expected body under limit to decode, got %v", err)
+	}
+}
+
+func TestCacheRespectsMaxResponseBytes(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items":[`))
+		for i := 0; i < 10000; i++ {
+			_, _ = w.Write([]byte(`"xxxxxxxxxxxxxxxx",`))
+		}
+		_, _ = w.Write([]byte(`"end"]}`))
+	}))
+	defer srv.Close()
+
+	cache := NewMemoryCache()
+	client := New(WithCache(cache), WithMaxResponseBytes(1024))
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	var out map[string]any
+	if err := client.DoJSON(req, &out); !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("expected ErrResponseTooLarge, got %v", err)
+	}
+	if _, ok := cache.Get(requestCacheKey(req)); ok {
+		t.Fatalf("expected oversized response not to be cached")
+	}
+}
+
+func TestDoBytes(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.Header().Set("X-Request-Id", "rid-404")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"404 File Not Found"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte("raw-content"))
+	}))
+	defer srv.Close()
+
+	client := New()
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/file", nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	data, headers, err := client.DoBytes(req)
+	if err != nil {
+		t.Fatalf("DoBytes failed: %v", err)
+	}
+	if string(data) != "raw-content" || headers.Get("Content-Type") != "text/plain" {
+		t.Fatalf("unexpected response: %q %v", data, headers)
+	}
+
+	req, err = http.NewRequest(http.MethodGet, srv.URL+"/missing", nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	_, _, err = client.DoBytes(req)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %T: %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.RequestID != "rid-404" || apiErr.Message() != "404 File Not Found" {
+		t.Fatalf("unexpected APIError: %+v", apiErr)
+	}
+}
+
+type recordingLogger struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *recordingLogger) Printf(format string, args ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, fmt.Sprintf(format, args...))
+}
+
+func TestDoLogsDurationWithRedactedURL(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	logger := &recordingLogger{}
+	client := New(WithLogger(logger))
+
+	target := strings.Replace(srv.URL, "http://", "http://user:secret@", 1) + "/path"
+	req, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	logger.mu.Lock()
+	defer logger.mu.Unlock()
+	if len(logger.lines) != 1 {
+		t.Fatalf("expected one log line, got %v", logger.lines)
+	}
+	line := logger.lines[0]
+	if strings.Contains(line, "secret") {
+		t.Fatalf("log line leaks password: %q", line)
+	}
+	if !regexp.MustCompile(`-> 200 in [0-9.]+(ns|µs|ms|s) \(attempt=1\)`).MatchString(line) {
+		t.Fatalf("log line missing duration: %q", line)
+	}
+}
+
+func TestWithResponseHookSeesRetriedAndFinalResponses(t *testing.T) {
+	t.Parallel()
+
+	attempt := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempt++
+		if attempt == 1 {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte("slow down"))
+			return
+		}
+		w.Header().Set("X-RateLimit-Remaining", "99")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer srv.Close()
+
+	var seen []string
+	client := New(
+		WithRetry(RetryConfig{MaxAttempts: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}),
+		WithResponseHook(func(resp *http.Response) {
+			body, _ := io.ReadAll(resp.Body)
+			if len(body) != 0 {
+				t.Errorf("hook should not see body, got %q", body)
+			}
+			seen = append(seen, fmt.Sprintf("%d:%s", resp.StatusCode, resp.Header.Get("X-RateLimit-Remaining")))
+		}),
+	)
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	var out struct {
+		OK bool `json:"ok"`
+	}
+	if err := client.DoJSON(req, &out); err != nil {
+		t.Fatalf("DoJSON failed: %v", err)
+	}
+	if !out.OK {
+		t.Fatalf("expected body to remain readable after hook")
+	}
+	if strings.Join(seen, ",") != "429:0,200:99" {
+		t.Fatalf("unexpected hook calls: %v", seen)
+	}
+}
+
+func TestDoSendsContextHeaders(t *testing.T) {
+	t.Parallel()
+
+	var got http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	client := New(WithBaseHeaders(http.Header{"X-Feature": []string{"base"}}))
+	ctx := ContextWithHeaders(context.Background(), http.Header{"x-atlassian-token": []string{"no-check"}})
+	ctx = ContextWithHeaders(ctx, http.Header{"X-Feature": []string{"beta"}, "X-Explicit": []string{"from-context"}})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	req.Header.Set("X-Explicit", "from-request")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if got.Get("X-Atlassian-Token") != "no-check" {
+		t.Fatalf("expected context header on the wire, got %v", got)
+	}
+	if values := got.Values("X-Feature"); len(values) != 1 || values[0] != "beta" {
+		t.Fatalf("expected context header to take precedence over base header, got %v", values)
+	}
+	if got.Get("X-Explicit") != "from-request" {
+		t.Fatalf("expected explicit request header to win, got %q", got.Get("X-Explicit"))
+	}
+}
+
+func TestWithConnectionPoolConfiguresTransport(t *testing.T) {
+	t.Parallel()
+
+	client := New(WithConnectionPool(64, 128, 45*time.Second), WithTimeout(5*time.Second))
+	tr, ok := client.httpClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("expected *http.Transport, got %T", client.httpClient.Transport)
+	}
+	if tr.MaxIdleConnsPerHost != 64 || tr.MaxConnsPerHost != 128 || tr.IdleConnTimeout != 45*time.Second {
+		t.Fatalf("unexpected pool settings: idle/host=%d conns/host=%d idle timeout=%s", tr.MaxIdleConnsPerHost, tr.MaxConnsPerHost, tr.IdleConnTimeout)
+	}
+	if !tr.ForceAttemptHTTP2 {
+		t.Fatalf("expected HTTP/2 to stay enabled")
+	}
+	if client.httpClient.Timeout != 5*time.Second {
+		t.Fatalf("expected timeout to be kept, got %s", client.httpClient.Timeout)
+	}
+
+	clone := client.Clone()
+	if clone.httpClient.Transport != tr {
+		t.Fatalf("expected clone to share pooled transport")
+	}
+
+	injected := &http.Client{}
+	custom := New(WithConnectionPool(64, 128, time.Minute), WithHTTPClient(injected))
+	if custom.httpClient != injected || injected.Transport != nil {
+		t.Fatalf("expected injected client to be left untouched")
+	}
+}
+
+type traceIDKey struct{}
+
+type contextRecordingLogger struct {
+	recordingLogger
+	traceIDs []string
+}
+
+func (l *contextRecordingLogger) PrintfContext(ctx context.Context, format string, args ...any) {
+	l.mu.Lock()
+	traceID, _ := ctx.Value(traceIDKey{}).(string)
+	l.traceIDs = append(l.traceIDs, traceID)
+	l.mu.Unlock()
+	l.Printf(format, args...)
+}
+
+func TestDoLogsWithContextLogger(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	logger := &contextRecordingLogger{}
+	client := New(WithLogger(logger))
+
+	ctx := context.WithValue(context.Background(), traceIDKey{}, "trace-7")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	logger.mu.Lock()
+	defer logger.mu.Unlock()
+	if len(logger.traceIDs) != 1 || logger.traceIDs[0] != "trace-7" {
+		t.Fatalf("expected request context in logger, got %v", logger.traceIDs)
+	}
+	if len(logger.lines) != 1 || !strings.Contains(logger.lines[0], "-> 204") {
+		t.Fatalf("unexpected log lines: %v", logger.lines)
+	}
+}
+
+func TestDoJSONStrictRejectsUnknownFields(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"values":[1,2],"objectEntries":[3]}`))
+	}))
+	defer srv.Close()
+
+	decode := func(client *Client) error {
+		req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+		if err != nil {
+			t.Fatalf("new request: %v", err)
+		}
+		var out struct {
+			Values []int `json:"values"`
+		}
+		return client.DoJSON(req, &out)
+	}
+
+	if err := decode(New()); err != nil {
+		t.Fatalf("expected lenient decode by default, got %v", err)
+	}
+	err := decode(New(WithStrictJSON(true)))
+	if err == nil || !strings.Contains(err.Error(), "objectEntries") {
+		t.Fatalf("expected unknown field error in strict mode, got %v", err)
+	}
+}
+
+func TestWithHedgingFastHedgeWinsAndSlowIsCancelled(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	slowCancelled := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			select {
+			case <-r.Context().Done():
+				close(slowCancelled)
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		_, _ = w.Write([]byte("fast"))
+	}))
+	defer srv.Close()
+
+	client := New(WithHedging(20*time.Millisecond, 1))
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+
+	started := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	if string(body) != "fast" {
+		t.Fatalf("expected hedged response, got %q", body)
+	}
+	if elapsed := time.Since(started); elapsed > 2*time.Second {
+		t.Fatalf("hedge did not shortcut slow request: %s", elapsed)
+	}
+	select {
+	case <-slowCancelled:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("expected slow request to be cancelled")
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 requests, got %d", got)
+	}
+}
+
+func TestWithHedgingSkipsNonIdempotentMethods(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	client := New(WithHedging(5*time.Millisecond, 2))
+	req, err := http.NewRequest(http.MethodPost, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("expected POST not to be hedged, got %d requests", got)
+	}
+}
+
+func TestWithRequestCompressionGzipsLargeJSONBodies(t *testing.T) {
+	t.Parallel()
+
+	type received struct {
+		encoding string
+		body     string
+	}
+	var (
+		mu   sync.Mutex
+		seen []received
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reader io.Reader = r.Body
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			zr, err := gzip.NewReader(r.Body)
+			if err != nil {
+				t.Errorf("gzip reader: %v", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			reader = zr
+		}
+		body, err := io.ReadAll(reader)
+		if err != nil {
+			t.Errorf("read body: %v", err)
+		}
+		mu.Lock()
+		seen = append(seen, received{encoding: r.Header.Get("Content-Encoding"), body: string(body)})
+		attempt := len(seen)
+		mu.Unlock()
+		if attempt == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	client := New(
+		WithRequestCompression(64),
+		WithRetry(RetryConfig{MaxAttempts: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}),
+	)
+	send := func(payload string) {
+		req, err := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(payload))
+		if err != nil {
+			t.Fatalf("new request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("Do failed: %v", err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	large := `{"qlQuery":"` + strings.Repeat("Name = NY-1 OR ", 10) + `Name = NY-2"}`
+	send(large)
+
+	mu.Lock()
+	if len(seen) != 2 {
+		t.Fatalf("expected original attempt and retry, got %d", len(seen))
+	}
+	for i, got := range seen {
+		if got.encoding != "gzip" || got.body != large {
+			t.Fatalf("attempt %d: expected gzip-encoded payload, got %+v", i+1, got)
+		}
+	}
+	seen = nil
+	mu.Unlock()
+
+	small := `{"qlQuery":"Name = NY-1"}`
+	send(small)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(seen) != 2 {
+		t.Fatalf("expected original attempt and retry, got %d", len(seen))
+	}
+	for i, got := range seen {
+		if got.encoding != "" || got.body != small {
+			t.Fatalf("attempt %d: expected plain payload under threshold, got %+v", i+1, got)
+		}
+	}
+}
+
+func TestDoLogsRetryDecisions(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	logger := &recordingLogger{}
+	client := New(
+		WithLogger(logger),
+		WithRetry(RetryConfig{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}),
+	)
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/flaky", nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	logger.mu.Lock()
+	defer logger.mu.Unlock()
+	if len(logger.lines) != 2 {
+		t.Fatalf("expected retry and final log lines, got %v", logger.lines)
+	}
+	if !regexp.MustCompile(`^transport: retrying GET \S+/flaky after \S+ due to status=503 \(attempt 1/3\)$`).MatchString(logger.lines[0]) {
+		t.Fatalf("unexpected retry line: %q", logger.lines[0])
+	}
+	if !strings.Contains(logger.lines[1], "-> 200") || !strings.Contains(logger.lines[1], "attempt=2") {
+		t.Fatalf("unexpected final line: %q", logger.lines[1])
+	}
+}
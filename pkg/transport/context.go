@@ -0,0 +1,84 @@
+package transport
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+)
+
+// RequestIDHeader carries the caller's request ID for log correlation.
+const RequestIDHeader = "X-Request-Id"
+
+// IdempotencyKeyHeader lets servers deduplicate retried create requests.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+type requestIDKey struct{}
+
+type idempotencyKey struct{}
+
+type headersKey struct{}
+
+// ContextWithRequestID returns ctx carrying request ID. Do sends it as
+// X-Request-Id unless the request already sets that header.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns request ID stored by ContextWithRequestID.
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// ContextWithIdempotencyKey returns ctx carrying idempotency key. Do sends
+// the same key as Idempotency-Key on every attempt unless the request already
+// sets that header.
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns key stored by ContextWithIdempotencyKey.
+func IdempotencyKeyFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	key, _ := ctx.Value(idempotencyKey{}).(string)
+	return key
+}
+
+// ContextWithHeaders returns ctx carrying extra headers for a single call.
+// Do adds them to the outgoing request unless the request already sets the
+// same header; they take precedence over WithBaseHeaders. Headers already in
+// ctx are kept and extended.
+func ContextWithHeaders(ctx context.Context, headers http.Header) context.Context {
+	merged := HeadersFromContext(ctx).Clone()
+	if merged == nil {
+		merged = http.Header{}
+	}
+	for key, values := range headers {
+		merged[http.CanonicalHeaderKey(key)] = append([]string(nil), values...)
+	}
+	return context.WithValue(ctx, headersKey{}, merged)
+}
+
+// HeadersFromContext returns headers stored by ContextWithHeaders.
+func HeadersFromContext(ctx context.Context) http.Header {
+	if ctx == nil {
+		return nil
+	}
+	headers, _ := ctx.Value(headersKey{}).(http.Header)
+	return headers
+}
+
+// NewIdempotencyKey returns a random UUIDv4-formatted key.
+func NewIdempotencyKey() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
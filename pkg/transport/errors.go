@@ -1,8 +1,12 @@
 package transport
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 // APIError describes non-2xx responses.
@@ -44,3 +48,59 @@ func NewAPIError(resp *http.Response, maxBodyBytes int64) *APIError {
 		RequestID:  reqID,
 	}
 }
+
+// DecodeJSON unmarshals captured error body into v.
+func (e *APIError) DecodeJSON(v any) error {
+	if e == nil || strings.TrimSpace(e.Body) == "" {
+		return errors.New("transport: api error body is empty")
+	}
+	if err := json.Unmarshal([]byte(e.Body), v); err != nil {
+		return fmt.Errorf("transport: decode api error body: %w", err)
+	}
+	return nil
+}
+
+// Message extracts human-readable message from common JSON error envelopes
+// (message, error, errorMessages[0], error_description). Returns empty string
+// when the body is not JSON or has none of these fields.
+func (e *APIError) Message() string {
+	var envelope struct {
+		Message          json.RawMessage `json:"message"`
+		Error            json.RawMessage `json:"error"`
+		ErrorMessages    []string        `json:"errorMessages"`
+		ErrorDescription string          `json:"error_description"`
+	}
+	if err := e.DecodeJSON(&envelope); err != nil {
+		return ""
+	}
+
+	if msg := rawMessageString(envelope.Message); msg != "" {
+		return msg
+	}
+	if msg := rawMessageString(envelope.Error); msg != "" {
+		return msg
+	}
+	for _, msg := range envelope.ErrorMessages {
+		if strings.TrimSpace(msg) != "" {
+			return msg
+		}
+	}
+	return envelope.ErrorDescription
+}
+
+// rawMessageString returns JSON string value or compact JSON for objects
+// (GitLab may return {"message":{"field":["is invalid"]}}).
+func rawMessageString(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return text
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, raw); err != nil {
+		return ""
+	}
+	return compact.String()
+}
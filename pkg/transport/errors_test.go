@@ -0,0 +1,53 @@
+package transport
+
+import "testing"
+
+func TestAPIErrorMessage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "jira errorMessages", body: `{"errorMessages":["Issue does not exist or you do not have permission to see it."],"errors":{}}`, want: "Issue does not exist or you do not have permission to see it."},
+		{name: "gitlab message", body: `{"message":"404 Project Not Found"}`, want: "404 Project Not Found"},
+		{name: "gitlab validation object", body: `{"message":{"title":["can't be blank"]}}`, want: `{"title":["can't be blank"]}`},
+		{name: "slack error", body: `{"ok":false,"error":"ratelimited"}`, want: "ratelimited"},
+		{name: "oauth error description", body: `{"error_description":"token expired"}`, want: "token expired"},
+		{name: "plain text", body: "Bad Gateway", want: ""},
+		{name: "empty", body: "", want: ""},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			apiErr := &APIError{StatusCode: 400, Body: tc.body}
+			if got := apiErr.Message(); got != tc.want {
+				t.Fatalf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAPIErrorDecodeJSON(t *testing.T) {
+	t.Parallel()
+
+	apiErr := &APIError{StatusCode: 400, Body: `{"errorMessages":[],"errors":{"summary":"You must specify a summary of the issue."}}`}
+
+	var payload struct {
+		Errors map[string]string `json:"errors"`
+	}
+	if err := apiErr.DecodeJSON(&payload); err != nil {
+		t.Fatalf("DecodeJSON failed: %v", err)
+	}
+	if payload.Errors["summary"] != "You must specify a summary of the issue." {
+		t.Fatalf("unexpected errors: %+v", payload.Errors)
+	}
+
+	if err := (&APIError{Body: "not json"}).DecodeJSON(&payload); err == nil {
+		t.Fatalf("expected error for non-JSON body")
+	}
+}
@@ -0,0 +1,145 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// WithHedging sends up to maxHedges extra copies of a GET or HEAD request
+// without body when the previous copy has not answered after delay. The first
+// response that is not a transport error or retryable status wins and the
+// other copies are cancelled. Hedges share the attempt's WithMaxConcurrent
+// slot and count as a single attempt for retries. delay <= 0 or
+// maxHedges <= 0 disables hedging (the default).
+func WithHedging(delay time.Duration, maxHedges int) Option {
+	return func(c *Client) {
+		if delay <= 0 || maxHedges <= 0 {
+			c.hedgeDelay = 0
+			c.maxHedges = 0
+			return
+		}
+		c.hedgeDelay = delay
+		c.maxHedges = maxHedges
+	}
+}
+
+// send performs a single attempt, hedging it when enabled for req.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	if !c.hedgeable(req) {
+		return c.httpClient.Do(req)
+	}
+	return c.sendHedged(req)
+}
+
+func (c *Client) hedgeable(req *http.Request) bool {
+	if c.maxHedges <= 0 || c.hedgeDelay <= 0 {
+		return false
+	}
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return false
+	}
+	return req.Body == nil || req.Body == http.NoBody
+}
+
+type hedgeResult struct {
+	index int
+	resp  *http.Response
+	err   error
+}
+
+func (c *Client) sendHedged(req *http.Request) (*http.Response, error) {
+	total := c.maxHedges + 1
+	results := make(chan hedgeResult, total)
+	cancels := make([]context.CancelFunc, 0, total)
+
+	launch := func() {
+		ctx, cancel := context.WithCancel(req.Context())
+		index := len(cancels)
+		cancels = append(cancels, cancel)
+		hedge := req.Clone(ctx)
+		go func() {
+			resp, err := c.httpClient.Do(hedge)
+			results <- hedgeResult{index: index, resp: resp, err: err}
+		}()
+	}
+	cancelOthers := func(keep int) {
+		for i, cancel := range cancels {
+			if i != keep {
+				cancel()
+			}
+		}
+	}
+
+	launch()
+	timer := time.NewTimer(c.hedgeDelay)
+	defer timer.Stop()
+
+	var (
+		fallback *hedgeResult
+		pending  = 1
+	)
+	for pending > 0 {
+		select {
+		case <-timer.C:
+			if len(cancels) < total {
+				launch()
+				pending++
+				timer.Reset(c.hedgeDelay)
+			}
+		case result := <-results:
+			pending--
+			if result.err == nil && !shouldRetryStatus(result.resp.StatusCode) {
+				if fallback != nil && fallback.resp != nil {
+					drainAndClose(fallback.resp.Body)
+				}
+				cancelOthers(result.index)
+				go discardHedges(results, pending)
+				result.resp.Body = &cancelOnClose{ReadCloser: result.resp.Body, cancel: cancels[result.index]}
+				return result.resp, nil
+			}
+			// Keep the latest failed response (it carries status and
+			// Retry-After for the retry loop); an error only without one.
+			r := result
+			switch {
+			case r.resp != nil:
+				if fallback != nil && fallback.resp != nil {
+					drainAndClose(fallback.resp.Body)
+				}
+				fallback = &r
+			case fallback == nil:
+				fallback = &r
+			}
+		}
+	}
+
+	cancelOthers(fallback.index)
+	if fallback.err != nil {
+		cancels[fallback.index]()
+		return nil, fallback.err
+	}
+	fallback.resp.Body = &cancelOnClose{ReadCloser: fallback.resp.Body, cancel: cancels[fallback.index]}
+	return fallback.resp, nil
+}
+
+// discardHedges closes responses of cancelled hedges that still complete.
+func discardHedges(results <-chan hedgeResult, pending int) {
+	for ; pending > 0; pending-- {
+		if result := <-results; result.resp != nil {
+			drainAndClose(result.resp.Body)
+		}
+	}
+}
+
+// cancelOnClose releases the winning hedge's context once its body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (r *cancelOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	r.cancel()
+	return err
+}
@@ -1,10 +1,14 @@
 package transport
 
 import (
+	"errors"
 	"io"
 	"net/url"
 )
 
+// ErrResponseTooLarge is returned when a response body exceeds WithMaxResponseBytes.
+var ErrResponseTooLarge = errors.New("transport: response body exceeds size limit")
+
 // ReadBodyLimited reads response body up to maxBytes.
 func ReadBodyLimited(reader io.Reader, maxBytes int64) ([]byte, error) {
 	if maxBytes <= 0 {
@@ -22,3 +26,27 @@ func EncodeQuery(values url.Values) string {
 	}
 	return values.Encode()
 }
+
+// maxBytesReader fails with ErrResponseTooLarge once more than remaining
+// bytes are available, instead of silently truncating.
+type maxBytesReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (m *maxBytesReader) Read(p []byte) (int, error) {
+	if m.remaining <= 0 {
+		var probe [1]byte
+		n, err := m.r.Read(probe[:])
+		if n > 0 {
+			return 0, ErrResponseTooLarge
+		}
+		return 0, err
+	}
+	if int64(len(p)) > m.remaining {
+		p = p[:m.remaining]
+	}
+	n, err := m.r.Read(p)
+	m.remaining -= int64(n)
+	return n, err
+}